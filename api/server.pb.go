@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +22,120 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Vote int32
+
+const (
+	// No vote, voting with it removes the vote.
+	Vote_VOTE_NONE Vote = 0
+	Vote_VOTE_UP   Vote = 1
+	Vote_VOTE_DOWN Vote = 2
+)
+
+// Enum value maps for Vote.
+var (
+	Vote_name = map[int32]string{
+		0: "VOTE_NONE",
+		1: "VOTE_UP",
+		2: "VOTE_DOWN",
+	}
+	Vote_value = map[string]int32{
+		"VOTE_NONE": 0,
+		"VOTE_UP":   1,
+		"VOTE_DOWN": 2,
+	}
+)
+
+func (x Vote) Enum() *Vote {
+	p := new(Vote)
+	*p = x
+	return p
+}
+
+func (x Vote) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Vote) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_proto_enumTypes[0].Descriptor()
+}
+
+func (Vote) Type() protoreflect.EnumType {
+	return &file_api_server_proto_enumTypes[0]
+}
+
+func (x Vote) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Vote.Descriptor instead.
+func (Vote) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{0}
+}
+
+type PostSort int32
+
+const (
+	// Same as OLDEST.
+	PostSort_POST_SORT_UNSPECIFIED    PostSort = 0
+	PostSort_POST_SORT_NEWEST         PostSort = 1
+	PostSort_POST_SORT_OLDEST         PostSort = 2
+	PostSort_POST_SORT_TOP_LIKED      PostSort = 3
+	PostSort_POST_SORT_MOST_COMMENTED PostSort = 4
+	// Likes weighted by age of the post.
+	PostSort_POST_SORT_HOT PostSort = 5
+	// Up votes minus down votes.
+	PostSort_POST_SORT_TOP_SCORE PostSort = 6
+)
+
+// Enum value maps for PostSort.
+var (
+	PostSort_name = map[int32]string{
+		0: "POST_SORT_UNSPECIFIED",
+		1: "POST_SORT_NEWEST",
+		2: "POST_SORT_OLDEST",
+		3: "POST_SORT_TOP_LIKED",
+		4: "POST_SORT_MOST_COMMENTED",
+		5: "POST_SORT_HOT",
+		6: "POST_SORT_TOP_SCORE",
+	}
+	PostSort_value = map[string]int32{
+		"POST_SORT_UNSPECIFIED":    0,
+		"POST_SORT_NEWEST":         1,
+		"POST_SORT_OLDEST":         2,
+		"POST_SORT_TOP_LIKED":      3,
+		"POST_SORT_MOST_COMMENTED": 4,
+		"POST_SORT_HOT":            5,
+		"POST_SORT_TOP_SCORE":      6,
+	}
+)
+
+func (x PostSort) Enum() *PostSort {
+	p := new(PostSort)
+	*p = x
+	return p
+}
+
+func (x PostSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_server_proto_enumTypes[1].Descriptor()
+}
+
+func (PostSort) Type() protoreflect.EnumType {
+	return &file_api_server_proto_enumTypes[1]
+}
+
+func (x PostSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostSort.Descriptor instead.
+func (PostSort) EnumDescriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{1}
+}
+
 type UserInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -74,6 +189,75 @@ func (x *UserInfo) GetName() string {
 	return ""
 }
 
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio       string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl string `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_server_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *User) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *User) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
 type PostBody struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -85,7 +269,7 @@ type PostBody struct {
 
 func (x *PostBody) Reset() {
 	*x = PostBody{}
-	mi := &file_api_server_proto_msgTypes[1]
+	mi := &file_api_server_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -97,7 +281,7 @@ func (x *PostBody) String() string {
 func (*PostBody) ProtoMessage() {}
 
 func (x *PostBody) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[1]
+	mi := &file_api_server_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,7 +294,7 @@ func (x *PostBody) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostBody.ProtoReflect.Descriptor instead.
 func (*PostBody) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{1}
+	return file_api_server_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PostBody) GetTitle() string {
@@ -132,17 +316,25 @@ type Post struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Post     *PostBody `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
-	Author   *UserInfo `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Likes    int64     `protobuf:"varint,4,opt,name=likes,proto3" json:"likes,omitempty"`
-	IsLiked  bool      `protobuf:"varint,5,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	Comments int64     `protobuf:"varint,6,opt,name=comments,proto3" json:"comments,omitempty"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Post      *PostBody              `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	Author    *UserInfo              `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Likes     int64                  `protobuf:"varint,4,opt,name=likes,proto3" json:"likes,omitempty"`
+	IsLiked   bool                   `protobuf:"varint,5,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	Comments  int64                  `protobuf:"varint,6,opt,name=comments,proto3" json:"comments,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Not set if the post was never edited.
+	EditedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	Reactions *Reactions             `protobuf:"bytes,10,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	Votes     *Votes                 `protobuf:"bytes,11,opt,name=votes,proto3" json:"votes,omitempty"`
+	// Set if a followee of the caller liked the post.
+	LikedBy *LikedBy `protobuf:"bytes,12,opt,name=liked_by,json=likedBy,proto3" json:"liked_by,omitempty"`
 }
 
 func (x *Post) Reset() {
 	*x = Post{}
-	mi := &file_api_server_proto_msgTypes[2]
+	mi := &file_api_server_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +346,7 @@ func (x *Post) String() string {
 func (*Post) ProtoMessage() {}
 
 func (x *Post) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[2]
+	mi := &file_api_server_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +359,7 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Post.ProtoReflect.Descriptor instead.
 func (*Post) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{2}
+	return file_api_server_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Post) GetId() int64 {
@@ -212,22 +404,133 @@ func (x *Post) GetComments() int64 {
 	return 0
 }
 
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Post) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Post) GetReactions() *Reactions {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Post) GetVotes() *Votes {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *Post) GetLikedBy() *LikedBy {
+	if x != nil {
+		return x.LikedBy
+	}
+	return nil
+}
+
+// "Liked by user and others" summary of a post.
+type LikedBy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Followee of the caller who liked the post the latest.
+	User *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Number of other users who liked the post.
+	Others int64 `protobuf:"varint,2,opt,name=others,proto3" json:"others,omitempty"`
+}
+
+func (x *LikedBy) Reset() {
+	*x = LikedBy{}
+	mi := &file_api_server_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikedBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikedBy) ProtoMessage() {}
+
+func (x *LikedBy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikedBy.ProtoReflect.Descriptor instead.
+func (*LikedBy) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LikedBy) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *LikedBy) GetOthers() int64 {
+	if x != nil {
+		return x.Others
+	}
+	return 0
+}
+
 type Comment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PostId  int64     `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Author  *UserInfo `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Body    string    `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	Likes   int64     `protobuf:"varint,5,opt,name=likes,proto3" json:"likes,omitempty"`
-	IsLiked bool      `protobuf:"varint,6,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId    int64                  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Author    *UserInfo              `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Body      string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Likes     int64                  `protobuf:"varint,5,opt,name=likes,proto3" json:"likes,omitempty"`
+	IsLiked   bool                   `protobuf:"varint,6,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Not set if the comment was never edited.
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	// 0 for top level comments.
+	ParentCommentId int64 `protobuf:"varint,10,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+	// Number of direct replies.
+	ReplyCount int64 `protobuf:"varint,11,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	// Deleted comment that still has replies is kept in the thread as a
+	// tombstone: author and body are cleared.
+	Deleted   bool       `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Reactions *Reactions `protobuf:"bytes,13,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	Votes     *Votes     `protobuf:"bytes,14,opt,name=votes,proto3" json:"votes,omitempty"`
 }
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_api_server_proto_msgTypes[3]
+	mi := &file_api_server_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -239,7 +542,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[3]
+	mi := &file_api_server_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +555,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{3}
+	return file_api_server_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Comment) GetId() int64 {
@@ -297,90 +600,90 @@ func (x *Comment) GetIsLiked() bool {
 	return false
 }
 
-type GetPostsReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetPostsReq) Reset() {
-	*x = GetPostsReq{}
-	mi := &file_api_server_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *GetPostsReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
 }
 
-func (*GetPostsReq) ProtoMessage() {}
-
-func (x *GetPostsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[4]
+func (x *Comment) GetParentCommentId() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ParentCommentId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetPostsReq.ProtoReflect.Descriptor instead.
-func (*GetPostsReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{4}
+func (x *Comment) GetReplyCount() int64 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
 }
 
-func (x *GetPostsReq) GetUserId() int64 {
+func (x *Comment) GetDeleted() bool {
 	if x != nil {
-		return x.UserId
+		return x.Deleted
 	}
-	return 0
+	return false
 }
 
-func (x *GetPostsReq) GetOffset() int64 {
+func (x *Comment) GetReactions() *Reactions {
 	if x != nil {
-		return x.Offset
+		return x.Reactions
 	}
-	return 0
+	return nil
 }
 
-func (x *GetPostsReq) GetLimit() int64 {
+func (x *Comment) GetVotes() *Votes {
 	if x != nil {
-		return x.Limit
+		return x.Votes
 	}
-	return 0
+	return nil
 }
 
-type GetPostsRsp struct {
+// Emoji reactions of a post or a comment. Reaction "like" is the same as
+// likes and is_liked.
+type Reactions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// Number of users by reaction, reactions nobody used are omitted.
+	Counts map[string]int64 `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Reactions of the caller.
+	Mine []string `protobuf:"bytes,2,rep,name=mine,proto3" json:"mine,omitempty"`
 }
 
-func (x *GetPostsRsp) Reset() {
-	*x = GetPostsRsp{}
-	mi := &file_api_server_proto_msgTypes[5]
+func (x *Reactions) Reset() {
+	*x = Reactions{}
+	mi := &file_api_server_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsRsp) String() string {
+func (x *Reactions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsRsp) ProtoMessage() {}
+func (*Reactions) ProtoMessage() {}
 
-func (x *GetPostsRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[5]
+func (x *Reactions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,42 +694,3221 @@ func (x *GetPostsRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsRsp.ProtoReflect.Descriptor instead.
-func (*GetPostsRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Reactions.ProtoReflect.Descriptor instead.
+func (*Reactions) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetPostsRsp) GetPosts() []*Post {
+func (x *Reactions) GetCounts() map[string]int64 {
 	if x != nil {
-		return x.Posts
+		return x.Counts
 	}
 	return nil
 }
 
-type CreatePostReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Reactions) GetMine() []string {
+	if x != nil {
+		return x.Mine
+	}
+	return nil
+}
+
+// Votes of a post or a comment.
+type Votes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// up - down.
+	Score int64 `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	Up    int64 `protobuf:"varint,2,opt,name=up,proto3" json:"up,omitempty"`
+	Down  int64 `protobuf:"varint,3,opt,name=down,proto3" json:"down,omitempty"`
+	// Vote of the caller.
+	Mine Vote `protobuf:"varint,4,opt,name=mine,proto3,enum=go_1C.Vote" json:"mine,omitempty"`
+}
+
+func (x *Votes) Reset() {
+	*x = Votes{}
+	mi := &file_api_server_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Votes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Votes) ProtoMessage() {}
+
+func (x *Votes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Votes.ProtoReflect.Descriptor instead.
+func (*Votes) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Votes) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Votes) GetUp() int64 {
+	if x != nil {
+		return x.Up
+	}
+	return 0
+}
+
+func (x *Votes) GetDown() int64 {
+	if x != nil {
+		return x.Down
+	}
+	return 0
+}
+
+func (x *Votes) GetMine() Vote {
+	if x != nil {
+		return x.Mine
+	}
+	return Vote_VOTE_NONE
+}
+
+type CommentNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment       `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Replies []*CommentNode `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"`
+	// There are more replies than returned, load them with GetCommentThread
+	// passing this comment as comment_id.
+	HasMoreReplies bool `protobuf:"varint,3,opt,name=has_more_replies,json=hasMoreReplies,proto3" json:"has_more_replies,omitempty"`
+}
+
+func (x *CommentNode) Reset() {
+	*x = CommentNode{}
+	mi := &file_api_server_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentNode) ProtoMessage() {}
+
+func (x *CommentNode) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentNode.ProtoReflect.Descriptor instead.
+func (*CommentNode) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CommentNode) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CommentNode) GetReplies() []*CommentNode {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *CommentNode) GetHasMoreReplies() bool {
+	if x != nil {
+		return x.HasMoreReplies
+	}
+	return false
+}
+
+// Previous version of the post, replaced at created_at.
+type PostRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Post      *PostBody              `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_api_server_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PostRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PostRevision) GetPost() *PostBody {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Previous version of the comment, replaced at created_at.
+type CommentRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body      string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *CommentRevision) Reset() {
+	*x = CommentRevision{}
+	mi := &file_api_server_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentRevision) ProtoMessage() {}
+
+func (x *CommentRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentRevision.ProtoReflect.Descriptor instead.
+func (*CommentRevision) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CommentRevision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CommentRevision) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CommentRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPostsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token from the previous response. If set, offset is ignored.
+	// Only NEWEST and OLDEST orders support page tokens, token must come
+	// from a response of the same order.
+	PageToken string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Sort      PostSort `protobuf:"varint,5,opt,name=sort,proto3,enum=go_1C.PostSort" json:"sort,omitempty"`
+	// Filters, zero values mean no filter.
+	AuthorId      int64                  `protobuf:"varint,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+}
+
+func (x *GetPostsReq) Reset() {
+	*x = GetPostsReq{}
+	mi := &file_api_server_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsReq) ProtoMessage() {}
+
+func (x *GetPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsReq.ProtoReflect.Descriptor instead.
+func (*GetPostsReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{11}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *GetPostsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPostsReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPostsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPostsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetPostsReq) GetSort() PostSort {
+	if x != nil {
+		return x.Sort
+	}
+	return PostSort_POST_SORT_UNSPECIFIED
+}
+
+func (x *GetPostsReq) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *GetPostsReq) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *GetPostsReq) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+type GetPostsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// Token of the next page, empty if there are no more posts.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetPostsRsp) Reset() {
+	*x = GetPostsRsp{}
+	mi := &file_api_server_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsRsp) ProtoMessage() {}
+
+func (x *GetPostsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsRsp.ProtoReflect.Descriptor instead.
+func (*GetPostsRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPostsRsp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetPostsRsp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreatePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Post   *PostBody `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *CreatePostReq) Reset() {
+	*x = CreatePostReq{}
+	mi := &file_api_server_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostReq) ProtoMessage() {}
+
+func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostReq.ProtoReflect.Descriptor instead.
+func (*CreatePostReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{13}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *CreatePostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePostReq) GetPost() *PostBody {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type CreatePostRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *CreatePostRsp) Reset() {
+	*x = CreatePostRsp{}
+	mi := &file_api_server_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRsp) ProtoMessage() {}
+
+func (x *CreatePostRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRsp.ProtoReflect.Descriptor instead.
+func (*CreatePostRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreatePostRsp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type EditPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64     `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Post   *PostBody `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *EditPostReq) Reset() {
+	*x = EditPostReq{}
+	mi := &file_api_server_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostReq) ProtoMessage() {}
+
+func (x *EditPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostReq.ProtoReflect.Descriptor instead.
+func (*EditPostReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{15}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *EditPostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditPostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *EditPostReq) GetPost() *PostBody {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type EditPostRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *EditPostRsp) Reset() {
+	*x = EditPostRsp{}
+	mi := &file_api_server_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditPostRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostRsp) ProtoMessage() {}
+
+func (x *EditPostRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostRsp.ProtoReflect.Descriptor instead.
+func (*EditPostRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EditPostRsp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type DeletePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeletePostReq) Reset() {
+	*x = DeletePostReq{}
+	mi := &file_api_server_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostReq) ProtoMessage() {}
+
+func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
+func (*DeletePostReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{17}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *DeletePostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeletePostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DeletePostRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePostRsp) Reset() {
+	*x = DeletePostRsp{}
+	mi := &file_api_server_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRsp) ProtoMessage() {}
+
+func (x *DeletePostRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRsp.ProtoReflect.Descriptor instead.
+func (*DeletePostRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{18}
+}
+
+type RestorePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *RestorePostReq) Reset() {
+	*x = RestorePostReq{}
+	mi := &file_api_server_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostReq) ProtoMessage() {}
+
+func (x *RestorePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostReq.ProtoReflect.Descriptor instead.
+func (*RestorePostReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RestorePostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type RestorePostRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *RestorePostRsp) Reset() {
+	*x = RestorePostRsp{}
+	mi := &file_api_server_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRsp) ProtoMessage() {}
+
+func (x *RestorePostRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRsp.ProtoReflect.Descriptor instead.
+func (*RestorePostRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RestorePostRsp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetPostHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetPostHistoryReq) Reset() {
+	*x = GetPostHistoryReq{}
+	mi := &file_api_server_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostHistoryReq) ProtoMessage() {}
+
+func (x *GetPostHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetPostHistoryReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPostHistoryReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetPostHistoryReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetPostHistoryReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Revisions are ordered from the newest to the oldest.
+type GetPostHistoryRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*PostRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetPostHistoryRsp) Reset() {
+	*x = GetPostHistoryRsp{}
+	mi := &file_api_server_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostHistoryRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostHistoryRsp) ProtoMessage() {}
+
+func (x *GetPostHistoryRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostHistoryRsp.ProtoReflect.Descriptor instead.
+func (*GetPostHistoryRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPostHistoryRsp) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type LikePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *LikePostReq) Reset() {
+	*x = LikePostReq{}
+	mi := &file_api_server_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostReq) ProtoMessage() {}
+
+func (x *LikePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
+func (*LikePostReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{23}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *LikePostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikePostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type LikePostRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LikePostRsp) Reset() {
+	*x = LikePostRsp{}
+	mi := &file_api_server_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikePostRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostRsp) ProtoMessage() {}
+
+func (x *LikePostRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostRsp.ProtoReflect.Descriptor instead.
+func (*LikePostRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{24}
+}
+
+type ListPostLikersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListPostLikersReq) Reset() {
+	*x = ListPostLikersReq{}
+	mi := &file_api_server_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostLikersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostLikersReq) ProtoMessage() {}
+
+func (x *ListPostLikersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostLikersReq.ProtoReflect.Descriptor instead.
+func (*ListPostLikersReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPostLikersReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ListPostLikersReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPostLikersReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Users who liked the post, the latest like first.
+type ListPostLikersRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*UserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListPostLikersRsp) Reset() {
+	*x = ListPostLikersRsp{}
+	mi := &file_api_server_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostLikersRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostLikersRsp) ProtoMessage() {}
+
+func (x *ListPostLikersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostLikersRsp.ProtoReflect.Descriptor instead.
+func (*ListPostLikersRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPostLikersRsp) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type DislikePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DislikePostReq) Reset() {
+	*x = DislikePostReq{}
+	mi := &file_api_server_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DislikePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikePostReq) ProtoMessage() {}
+
+func (x *DislikePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikePostReq.ProtoReflect.Descriptor instead.
+func (*DislikePostReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{27}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *DislikePostReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DislikePostReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+type DislikePostRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DislikePostRsp) Reset() {
+	*x = DislikePostRsp{}
+	mi := &file_api_server_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DislikePostRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikePostRsp) ProtoMessage() {}
+
+func (x *DislikePostRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikePostRsp.ProtoReflect.Descriptor instead.
+func (*DislikePostRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{28}
+}
+
+type GetCommentsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64 `protobuf:"varint,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token from the previous response. If set, offset is ignored.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetCommentsReq) Reset() {
+	*x = GetCommentsReq{}
+	mi := &file_api_server_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsReq) ProtoMessage() {}
+
+func (x *GetCommentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsReq.ProtoReflect.Descriptor instead.
+func (*GetCommentsReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{29}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *GetCommentsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCommentsReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetCommentsReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCommentsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentsReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetCommentsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// Token of the next page, empty if there are no more comments.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetCommentsRsp) Reset() {
+	*x = GetCommentsRsp{}
+	mi := &file_api_server_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsRsp) ProtoMessage() {}
+
+func (x *GetCommentsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsRsp.ProtoReflect.Descriptor instead.
+func (*GetCommentsRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCommentsRsp) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetCommentsRsp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// At least one of post_id and comment_id must be set.
+type GetCommentThreadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Root of the thread. If set, replies to this comment are returned,
+	// otherwise top level comments of the post.
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// Number of levels to return, 3 by default.
+	Depth int32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Number of comments per level, i.e. replies of every comment are limited separately.
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Offset of the first level.
+	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *GetCommentThreadReq) Reset() {
+	*x = GetCommentThreadReq{}
+	mi := &file_api_server_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentThreadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentThreadReq) ProtoMessage() {}
+
+func (x *GetCommentThreadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentThreadReq.ProtoReflect.Descriptor instead.
+func (*GetCommentThreadReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetCommentThreadReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *GetCommentThreadReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *GetCommentThreadReq) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetCommentThreadReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentThreadReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetCommentThreadRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments []*CommentNode `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	// There are more comments on the first level.
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (x *GetCommentThreadRsp) Reset() {
+	*x = GetCommentThreadRsp{}
+	mi := &file_api_server_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentThreadRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentThreadRsp) ProtoMessage() {}
+
+func (x *GetCommentThreadRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentThreadRsp.ProtoReflect.Descriptor instead.
+func (*GetCommentThreadRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetCommentThreadRsp) GetComments() []*CommentNode {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetCommentThreadRsp) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type CreateCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PostId int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Body   string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Comment to reply to, 0 for top level comment.
+	ParentCommentId int64 `protobuf:"varint,4,opt,name=parent_comment_id,json=parentCommentId,proto3" json:"parent_comment_id,omitempty"`
+}
+
+func (x *CreateCommentReq) Reset() {
+	*x = CreateCommentReq{}
+	mi := &file_api_server_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentReq) ProtoMessage() {}
+
+func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
+func (*CreateCommentReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{33}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *CreateCommentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCommentReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *CreateCommentReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetParentCommentId() int64 {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return 0
+}
+
+type CreateCommentRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *CreateCommentRsp) Reset() {
+	*x = CreateCommentRsp{}
+	mi := &file_api_server_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRsp) ProtoMessage() {}
+
+func (x *CreateCommentRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRsp.ProtoReflect.Descriptor instead.
+func (*CreateCommentRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateCommentRsp) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type EditCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId    int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Body      string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *EditCommentReq) Reset() {
+	*x = EditCommentReq{}
+	mi := &file_api_server_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentReq) ProtoMessage() {}
+
+func (x *EditCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentReq.ProtoReflect.Descriptor instead.
+func (*EditCommentReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{35}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *EditCommentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditCommentReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *EditCommentReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type EditCommentRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *EditCommentRsp) Reset() {
+	*x = EditCommentRsp{}
+	mi := &file_api_server_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRsp) ProtoMessage() {}
+
+func (x *EditCommentRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRsp.ProtoReflect.Descriptor instead.
+func (*EditCommentRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EditCommentRsp) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type DeleteCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *DeleteCommentReq) Reset() {
+	*x = DeleteCommentReq{}
+	mi := &file_api_server_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentReq) ProtoMessage() {}
+
+func (x *DeleteCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentReq.ProtoReflect.Descriptor instead.
+func (*DeleteCommentReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{37}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *DeleteCommentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteCommentReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type DeleteCommentRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCommentRsp) Reset() {
+	*x = DeleteCommentRsp{}
+	mi := &file_api_server_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRsp) ProtoMessage() {}
+
+func (x *DeleteCommentRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRsp.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{38}
+}
+
+type RestoreCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *RestoreCommentReq) Reset() {
+	*x = RestoreCommentReq{}
+	mi := &file_api_server_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommentReq) ProtoMessage() {}
+
+func (x *RestoreCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommentReq.ProtoReflect.Descriptor instead.
+func (*RestoreCommentReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreCommentReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type RestoreCommentRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *RestoreCommentRsp) Reset() {
+	*x = RestoreCommentRsp{}
+	mi := &file_api_server_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCommentRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCommentRsp) ProtoMessage() {}
+
+func (x *RestoreCommentRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCommentRsp.ProtoReflect.Descriptor instead.
+func (*RestoreCommentRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreCommentRsp) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type GetCommentHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Offset    int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetCommentHistoryReq) Reset() {
+	*x = GetCommentHistoryReq{}
+	mi := &file_api_server_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentHistoryReq) ProtoMessage() {}
+
+func (x *GetCommentHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetCommentHistoryReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCommentHistoryReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *GetCommentHistoryReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCommentHistoryReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Revisions are ordered from the newest to the oldest.
+type GetCommentHistoryRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*CommentRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *GetCommentHistoryRsp) Reset() {
+	*x = GetCommentHistoryRsp{}
+	mi := &file_api_server_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommentHistoryRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentHistoryRsp) ProtoMessage() {}
+
+func (x *GetCommentHistoryRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentHistoryRsp.ProtoReflect.Descriptor instead.
+func (*GetCommentHistoryRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetCommentHistoryRsp) GetRevisions() []*CommentRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// Exactly one of post_id and comment_id must be set.
+type ReactReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId    int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentId int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Reaction  string `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+}
+
+func (x *ReactReq) Reset() {
+	*x = ReactReq{}
+	mi := &file_api_server_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactReq) ProtoMessage() {}
+
+func (x *ReactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactReq.ProtoReflect.Descriptor instead.
+func (*ReactReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReactReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *ReactReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ReactReq) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type ReactRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions *Reactions `protobuf:"bytes,1,opt,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *ReactRsp) Reset() {
+	*x = ReactRsp{}
+	mi := &file_api_server_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactRsp) ProtoMessage() {}
+
+func (x *ReactRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactRsp.ProtoReflect.Descriptor instead.
+func (*ReactRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReactRsp) GetReactions() *Reactions {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// Exactly one of post_id and comment_id must be set.
+type UnreactReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId    int64  `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentId int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Reaction  string `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+}
+
+func (x *UnreactReq) Reset() {
+	*x = UnreactReq{}
+	mi := &file_api_server_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactReq) ProtoMessage() {}
+
+func (x *UnreactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactReq.ProtoReflect.Descriptor instead.
+func (*UnreactReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnreactReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *UnreactReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *UnreactReq) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+type UnreactRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reactions *Reactions `protobuf:"bytes,1,opt,name=reactions,proto3" json:"reactions,omitempty"`
+}
+
+func (x *UnreactRsp) Reset() {
+	*x = UnreactRsp{}
+	mi := &file_api_server_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnreactRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreactRsp) ProtoMessage() {}
+
+func (x *UnreactRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreactRsp.ProtoReflect.Descriptor instead.
+func (*UnreactRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UnreactRsp) GetReactions() *Reactions {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+// Exactly one of post_id and comment_id must be set. Voting again the same
+// way is not an error.
+type VoteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId    int64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Vote      Vote  `protobuf:"varint,3,opt,name=vote,proto3,enum=go_1C.Vote" json:"vote,omitempty"`
+}
+
+func (x *VoteReq) Reset() {
+	*x = VoteReq{}
+	mi := &file_api_server_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteReq) ProtoMessage() {}
+
+func (x *VoteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteReq.ProtoReflect.Descriptor instead.
+func (*VoteReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *VoteReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *VoteReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *VoteReq) GetVote() Vote {
+	if x != nil {
+		return x.Vote
+	}
+	return Vote_VOTE_NONE
+}
+
+type VoteRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Votes *Votes `protobuf:"bytes,1,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *VoteRsp) Reset() {
+	*x = VoteRsp{}
+	mi := &file_api_server_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteRsp) ProtoMessage() {}
+
+func (x *VoteRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteRsp.ProtoReflect.Descriptor instead.
+func (*VoteRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VoteRsp) GetVotes() *Votes {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type LikeCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *LikeCommentReq) Reset() {
+	*x = LikeCommentReq{}
+	mi := &file_api_server_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentReq) ProtoMessage() {}
+
+func (x *LikeCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentReq.ProtoReflect.Descriptor instead.
+func (*LikeCommentReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{49}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *LikeCommentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LikeCommentReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type LikeCommentRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LikeCommentRsp) Reset() {
+	*x = LikeCommentRsp{}
+	mi := &file_api_server_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentRsp) ProtoMessage() {}
+
+func (x *LikeCommentRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentRsp.ProtoReflect.Descriptor instead.
+func (*LikeCommentRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{50}
+}
+
+type ListCommentLikersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentId int64 `protobuf:"varint,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Offset    int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListCommentLikersReq) Reset() {
+	*x = ListCommentLikersReq{}
+	mi := &file_api_server_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentLikersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentLikersReq) ProtoMessage() {}
+
+func (x *ListCommentLikersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentLikersReq.ProtoReflect.Descriptor instead.
+func (*ListCommentLikersReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListCommentLikersReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+func (x *ListCommentLikersReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCommentLikersReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Users who liked the comment, the latest like first.
+type ListCommentLikersRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*UserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListCommentLikersRsp) Reset() {
+	*x = ListCommentLikersRsp{}
+	mi := &file_api_server_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentLikersRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentLikersRsp) ProtoMessage() {}
+
+func (x *ListCommentLikersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentLikersRsp.ProtoReflect.Descriptor instead.
+func (*ListCommentLikersRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListCommentLikersRsp) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type DislikeCommentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: caller is taken from the Authorization header.
+	//
+	// Deprecated: Marked as deprecated in api/server.proto.
+	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *DislikeCommentReq) Reset() {
+	*x = DislikeCommentReq{}
+	mi := &file_api_server_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DislikeCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikeCommentReq) ProtoMessage() {}
+
+func (x *DislikeCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikeCommentReq.ProtoReflect.Descriptor instead.
+func (*DislikeCommentReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{53}
+}
+
+// Deprecated: Marked as deprecated in api/server.proto.
+func (x *DislikeCommentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DislikeCommentReq) GetCommentId() int64 {
+	if x != nil {
+		return x.CommentId
+	}
+	return 0
+}
+
+type DislikeCommentRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DislikeCommentRsp) Reset() {
+	*x = DislikeCommentRsp{}
+	mi := &file_api_server_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DislikeCommentRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DislikeCommentRsp) ProtoMessage() {}
+
+func (x *DislikeCommentRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DislikeCommentRsp.ProtoReflect.Descriptor instead.
+func (*DislikeCommentRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{54}
+}
+
+type FollowReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolloweeId int64 `protobuf:"varint,1,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+}
+
+func (x *FollowReq) Reset() {
+	*x = FollowReq{}
+	mi := &file_api_server_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowReq) ProtoMessage() {}
+
+func (x *FollowReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowReq.ProtoReflect.Descriptor instead.
+func (*FollowReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FollowReq) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+type FollowRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FollowRsp) Reset() {
+	*x = FollowRsp{}
+	mi := &file_api_server_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRsp) ProtoMessage() {}
+
+func (x *FollowRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRsp.ProtoReflect.Descriptor instead.
+func (*FollowRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{56}
+}
+
+type UnfollowReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolloweeId int64 `protobuf:"varint,1,opt,name=followee_id,json=followeeId,proto3" json:"followee_id,omitempty"`
+}
+
+func (x *UnfollowReq) Reset() {
+	*x = UnfollowReq{}
+	mi := &file_api_server_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowReq) ProtoMessage() {}
+
+func (x *UnfollowReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowReq.ProtoReflect.Descriptor instead.
+func (*UnfollowReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UnfollowReq) GetFolloweeId() int64 {
+	if x != nil {
+		return x.FolloweeId
+	}
+	return 0
+}
+
+type UnfollowRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnfollowRsp) Reset() {
+	*x = UnfollowRsp{}
+	mi := &file_api_server_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowRsp) ProtoMessage() {}
+
+func (x *UnfollowRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowRsp.ProtoReflect.Descriptor instead.
+func (*UnfollowRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{58}
+}
+
+type ListFollowersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListFollowersReq) Reset() {
+	*x = ListFollowersReq{}
+	mi := &file_api_server_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersReq) ProtoMessage() {}
+
+func (x *ListFollowersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersReq.ProtoReflect.Descriptor instead.
+func (*ListFollowersReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListFollowersReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
 
-	UserId int64     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Post   *PostBody `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+func (x *ListFollowersReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *CreatePostReq) Reset() {
-	*x = CreatePostReq{}
-	mi := &file_api_server_proto_msgTypes[6]
+func (x *ListFollowersReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFollowersRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*UserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListFollowersRsp) Reset() {
+	*x = ListFollowersRsp{}
+	mi := &file_api_server_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostReq) String() string {
+func (x *ListFollowersRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostReq) ProtoMessage() {}
+func (*ListFollowersRsp) ProtoMessage() {}
 
-func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[6]
+func (x *ListFollowersRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersRsp.ProtoReflect.Descriptor instead.
+func (*ListFollowersRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListFollowersRsp) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ListFollowingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListFollowingReq) Reset() {
+	*x = ListFollowingReq{}
+	mi := &file_api_server_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingReq) ProtoMessage() {}
+
+func (x *ListFollowingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingReq.ProtoReflect.Descriptor instead.
+func (*ListFollowingReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListFollowingReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListFollowingReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListFollowingReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFollowingRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*UserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ListFollowingRsp) Reset() {
+	*x = ListFollowingRsp{}
+	mi := &file_api_server_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingRsp) ProtoMessage() {}
+
+func (x *ListFollowingRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingRsp.ProtoReflect.Descriptor instead.
+func (*ListFollowingRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListFollowingRsp) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Feed of the caller: posts of followed users from the newest to the oldest.
+type GetFeedReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token from the previous response.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetFeedReq) Reset() {
+	*x = GetFeedReq{}
+	mi := &file_api_server_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedReq) ProtoMessage() {}
+
+func (x *GetFeedReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedReq.ProtoReflect.Descriptor instead.
+func (*GetFeedReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetFeedReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFeedReq) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetFeedRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	// Token of the next page, empty if there are no more posts.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetFeedRsp) Reset() {
+	*x = GetFeedRsp{}
+	mi := &file_api_server_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedRsp) ProtoMessage() {}
+
+func (x *GetFeedRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedRsp.ProtoReflect.Descriptor instead.
+func (*GetFeedRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetFeedRsp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetFeedRsp) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type Tokens struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed short-lived token, send it as "Authorization: Bearer <access_token>".
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Opaque long-lived token, exchange it for a new pair with RefreshToken.
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// Access token lifetime in seconds.
+	ExpiresIn int64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+}
+
+func (x *Tokens) Reset() {
+	*x = Tokens{}
+	mi := &file_api_server_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tokens) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tokens) ProtoMessage() {}
+
+func (x *Tokens) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tokens.ProtoReflect.Descriptor instead.
+func (*Tokens) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Tokens) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *Tokens) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *Tokens) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type RegisterReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RegisterReq) Reset() {
+	*x = RegisterReq{}
+	mi := &file_api_server_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterReq) ProtoMessage() {}
+
+func (x *RegisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,48 +3919,56 @@ func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostReq.ProtoReflect.Descriptor instead.
-func (*CreatePostReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RegisterReq.ProtoReflect.Descriptor instead.
+func (*RegisterReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RegisterReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
 }
 
-func (x *CreatePostReq) GetUserId() int64 {
+func (x *RegisterReq) GetPassword() string {
 	if x != nil {
-		return x.UserId
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-func (x *CreatePostReq) GetPost() *PostBody {
+func (x *RegisterReq) GetName() string {
 	if x != nil {
-		return x.Post
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CreatePostRsp struct {
+type RegisterRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	User   *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Tokens *Tokens   `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-func (x *CreatePostRsp) Reset() {
-	*x = CreatePostRsp{}
-	mi := &file_api_server_proto_msgTypes[7]
+func (x *RegisterRsp) Reset() {
+	*x = RegisterRsp{}
+	mi := &file_api_server_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostRsp) String() string {
+func (x *RegisterRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRsp) ProtoMessage() {}
+func (*RegisterRsp) ProtoMessage() {}
 
-func (x *CreatePostRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[7]
+func (x *RegisterRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,43 +3979,49 @@ func (x *CreatePostRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRsp.ProtoReflect.Descriptor instead.
-func (*CreatePostRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RegisterRsp.ProtoReflect.Descriptor instead.
+func (*RegisterRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CreatePostRsp) GetPost() *Post {
+func (x *RegisterRsp) GetUser() *UserInfo {
 	if x != nil {
-		return x.Post
+		return x.User
 	}
 	return nil
 }
 
-type EditPostReq struct {
+func (x *RegisterRsp) GetTokens() *Tokens {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type LoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64     `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64     `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Post   *PostBody `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *EditPostReq) Reset() {
-	*x = EditPostReq{}
-	mi := &file_api_server_proto_msgTypes[8]
+func (x *LoginReq) Reset() {
+	*x = LoginReq{}
+	mi := &file_api_server_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditPostReq) String() string {
+func (x *LoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditPostReq) ProtoMessage() {}
+func (*LoginReq) ProtoMessage() {}
 
-func (x *EditPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[8]
+func (x *LoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,55 +4032,49 @@ func (x *EditPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditPostReq.ProtoReflect.Descriptor instead.
-func (*EditPostReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *EditPostReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
+func (*LoginReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *EditPostReq) GetPostId() int64 {
+func (x *LoginReq) GetLogin() string {
 	if x != nil {
-		return x.PostId
+		return x.Login
 	}
-	return 0
+	return ""
 }
 
-func (x *EditPostReq) GetPost() *PostBody {
+func (x *LoginReq) GetPassword() string {
 	if x != nil {
-		return x.Post
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-type EditPostRsp struct {
+type LoginRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	User   *UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Tokens *Tokens   `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-func (x *EditPostRsp) Reset() {
-	*x = EditPostRsp{}
-	mi := &file_api_server_proto_msgTypes[9]
+func (x *LoginRsp) Reset() {
+	*x = LoginRsp{}
+	mi := &file_api_server_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditPostRsp) String() string {
+func (x *LoginRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditPostRsp) ProtoMessage() {}
+func (*LoginRsp) ProtoMessage() {}
 
-func (x *EditPostRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[9]
+func (x *LoginRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,42 +4085,48 @@ func (x *EditPostRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditPostRsp.ProtoReflect.Descriptor instead.
-func (*EditPostRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use LoginRsp.ProtoReflect.Descriptor instead.
+func (*LoginRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *EditPostRsp) GetPost() *Post {
+func (x *LoginRsp) GetUser() *UserInfo {
 	if x != nil {
-		return x.Post
+		return x.User
 	}
 	return nil
 }
 
-type DeletePostReq struct {
+func (x *LoginRsp) GetTokens() *Tokens {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type LogoutReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *DeletePostReq) Reset() {
-	*x = DeletePostReq{}
-	mi := &file_api_server_proto_msgTypes[10]
+func (x *LogoutReq) Reset() {
+	*x = LogoutReq{}
+	mi := &file_api_server_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostReq) String() string {
+func (x *LogoutReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostReq) ProtoMessage() {}
+func (*LogoutReq) ProtoMessage() {}
 
-func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[10]
+func (x *LogoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -641,46 +4137,39 @@ func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
-func (*DeletePostReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *DeletePostReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use LogoutReq.ProtoReflect.Descriptor instead.
+func (*LogoutReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *DeletePostReq) GetPostId() int64 {
+func (x *LogoutReq) GetRefreshToken() string {
 	if x != nil {
-		return x.PostId
+		return x.RefreshToken
 	}
-	return 0
+	return ""
 }
 
-type DeletePostRsp struct {
+type LogoutRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeletePostRsp) Reset() {
-	*x = DeletePostRsp{}
-	mi := &file_api_server_proto_msgTypes[11]
+func (x *LogoutRsp) Reset() {
+	*x = LogoutRsp{}
+	mi := &file_api_server_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRsp) String() string {
+func (x *LogoutRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRsp) ProtoMessage() {}
+func (*LogoutRsp) ProtoMessage() {}
 
-func (x *DeletePostRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[11]
+func (x *LogoutRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,35 +4180,34 @@ func (x *DeletePostRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRsp.ProtoReflect.Descriptor instead.
-func (*DeletePostRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LogoutRsp.ProtoReflect.Descriptor instead.
+func (*LogoutRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{71}
 }
 
-type LikePostReq struct {
+type RefreshTokenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	RefreshToken string `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 }
 
-func (x *LikePostReq) Reset() {
-	*x = LikePostReq{}
-	mi := &file_api_server_proto_msgTypes[12]
+func (x *RefreshTokenReq) Reset() {
+	*x = RefreshTokenReq{}
+	mi := &file_api_server_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostReq) String() string {
+func (x *RefreshTokenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostReq) ProtoMessage() {}
+func (*RefreshTokenReq) ProtoMessage() {}
 
-func (x *LikePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[12]
+func (x *RefreshTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -730,46 +4218,41 @@ func (x *LikePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
-func (*LikePostReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *LikePostReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use RefreshTokenReq.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *LikePostReq) GetPostId() int64 {
+func (x *RefreshTokenReq) GetRefreshToken() string {
 	if x != nil {
-		return x.PostId
+		return x.RefreshToken
 	}
-	return 0
+	return ""
 }
 
-type LikePostRsp struct {
+type RefreshTokenRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tokens *Tokens `protobuf:"bytes,1,opt,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-func (x *LikePostRsp) Reset() {
-	*x = LikePostRsp{}
-	mi := &file_api_server_proto_msgTypes[13]
+func (x *RefreshTokenRsp) Reset() {
+	*x = RefreshTokenRsp{}
+	mi := &file_api_server_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostRsp) String() string {
+func (x *RefreshTokenRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostRsp) ProtoMessage() {}
+func (*RefreshTokenRsp) ProtoMessage() {}
 
-func (x *LikePostRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[13]
+func (x *RefreshTokenRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -780,35 +4263,46 @@ func (x *LikePostRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostRsp.ProtoReflect.Descriptor instead.
-func (*LikePostRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RefreshTokenRsp.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{73}
 }
 
-type DislikePostReq struct {
+func (x *RefreshTokenRsp) GetTokens() *Tokens {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// Query uses web search syntax: "quoted phrase", OR, -excluded word.
+type SearchPostsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Zero means any author.
+	AuthorId int64 `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Offset   int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *DislikePostReq) Reset() {
-	*x = DislikePostReq{}
-	mi := &file_api_server_proto_msgTypes[14]
+func (x *SearchPostsReq) Reset() {
+	*x = SearchPostsReq{}
+	mi := &file_api_server_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DislikePostReq) String() string {
+func (x *SearchPostsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DislikePostReq) ProtoMessage() {}
+func (*SearchPostsReq) ProtoMessage() {}
 
-func (x *DislikePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[14]
+func (x *SearchPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,46 +4313,67 @@ func (x *DislikePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DislikePostReq.ProtoReflect.Descriptor instead.
-func (*DislikePostReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SearchPostsReq.ProtoReflect.Descriptor instead.
+func (*SearchPostsReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DislikePostReq) GetUserId() int64 {
+func (x *SearchPostsReq) GetQuery() string {
 	if x != nil {
-		return x.UserId
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsReq) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *DislikePostReq) GetPostId() int64 {
+func (x *SearchPostsReq) GetOffset() int64 {
 	if x != nil {
-		return x.PostId
+		return x.Offset
 	}
 	return 0
 }
 
-type DislikePostRsp struct {
+func (x *SearchPostsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Snippets are fragments of the text escaped as HTML with matches wrapped in
+// <mark></mark>, they are safe to insert into a page as is.
+type PostSearchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Post         *Post   `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Rank         float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	TitleSnippet string  `protobuf:"bytes,3,opt,name=title_snippet,json=titleSnippet,proto3" json:"title_snippet,omitempty"`
+	BodySnippet  string  `protobuf:"bytes,4,opt,name=body_snippet,json=bodySnippet,proto3" json:"body_snippet,omitempty"`
 }
 
-func (x *DislikePostRsp) Reset() {
-	*x = DislikePostRsp{}
-	mi := &file_api_server_proto_msgTypes[15]
+func (x *PostSearchResult) Reset() {
+	*x = PostSearchResult{}
+	mi := &file_api_server_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DislikePostRsp) String() string {
+func (x *PostSearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DislikePostRsp) ProtoMessage() {}
+func (*PostSearchResult) ProtoMessage() {}
 
-func (x *DislikePostRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[15]
+func (x *PostSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,37 +4384,63 @@ func (x *DislikePostRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DislikePostRsp.ProtoReflect.Descriptor instead.
-func (*DislikePostRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use PostSearchResult.ProtoReflect.Descriptor instead.
+func (*PostSearchResult) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{75}
 }
 
-type GetCommentsReq struct {
+func (x *PostSearchResult) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostSearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PostSearchResult) GetTitleSnippet() string {
+	if x != nil {
+		return x.TitleSnippet
+	}
+	return ""
+}
+
+func (x *PostSearchResult) GetBodySnippet() string {
+	if x != nil {
+		return x.BodySnippet
+	}
+	return ""
+}
+
+// Results are ordered by rank.
+type SearchPostsRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64 `protobuf:"varint,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit  int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Results []*PostSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *GetCommentsReq) Reset() {
-	*x = GetCommentsReq{}
-	mi := &file_api_server_proto_msgTypes[16]
+func (x *SearchPostsRsp) Reset() {
+	*x = SearchPostsRsp{}
+	mi := &file_api_server_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsReq) String() string {
+func (x *SearchPostsRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsReq) ProtoMessage() {}
+func (*SearchPostsRsp) ProtoMessage() {}
 
-func (x *GetCommentsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[16]
+func (x *SearchPostsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,62 +4451,47 @@ func (x *GetCommentsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsReq.ProtoReflect.Descriptor instead.
-func (*GetCommentsReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetCommentsReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *GetCommentsReq) GetPostId() int64 {
-	if x != nil {
-		return x.PostId
-	}
-	return 0
-}
-
-func (x *GetCommentsReq) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use SearchPostsRsp.ProtoReflect.Descriptor instead.
+func (*SearchPostsRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetCommentsReq) GetLimit() int64 {
+func (x *SearchPostsRsp) GetResults() []*PostSearchResult {
 	if x != nil {
-		return x.Limit
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-type GetCommentsRsp struct {
+type SearchCommentsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Zero means any author.
+	AuthorId int64 `protobuf:"varint,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Zero means any post.
+	PostId int64 `protobuf:"varint,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit  int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetCommentsRsp) Reset() {
-	*x = GetCommentsRsp{}
-	mi := &file_api_server_proto_msgTypes[17]
+func (x *SearchCommentsReq) Reset() {
+	*x = SearchCommentsReq{}
+	mi := &file_api_server_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommentsRsp) String() string {
+func (x *SearchCommentsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsRsp) ProtoMessage() {}
+func (*SearchCommentsReq) ProtoMessage() {}
 
-func (x *GetCommentsRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[17]
+func (x *SearchCommentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -976,43 +4502,72 @@ func (x *GetCommentsRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsRsp.ProtoReflect.Descriptor instead.
-func (*GetCommentsRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SearchCommentsReq.ProtoReflect.Descriptor instead.
+func (*SearchCommentsReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetCommentsRsp) GetComments() []*Comment {
+func (x *SearchCommentsReq) GetQuery() string {
 	if x != nil {
-		return x.Comments
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-type CreateCommentReq struct {
+func (x *SearchCommentsReq) GetAuthorId() int64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *SearchCommentsReq) GetPostId() int64 {
+	if x != nil {
+		return x.PostId
+	}
+	return 0
+}
+
+func (x *SearchCommentsReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchCommentsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Snippet is made the same way as snippets of PostSearchResult.
+type CommentSearchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PostId int64  `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Body   string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Rank    float32  `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet string   `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
 }
 
-func (x *CreateCommentReq) Reset() {
-	*x = CreateCommentReq{}
-	mi := &file_api_server_proto_msgTypes[18]
+func (x *CommentSearchResult) Reset() {
+	*x = CommentSearchResult{}
+	mi := &file_api_server_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentReq) String() string {
+func (x *CommentSearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentReq) ProtoMessage() {}
+func (*CommentSearchResult) ProtoMessage() {}
 
-func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[18]
+func (x *CommentSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,55 +4578,56 @@ func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
-func (*CreateCommentReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CommentSearchResult.ProtoReflect.Descriptor instead.
+func (*CommentSearchResult) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *CreateCommentReq) GetUserId() int64 {
+func (x *CommentSearchResult) GetComment() *Comment {
 	if x != nil {
-		return x.UserId
+		return x.Comment
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateCommentReq) GetPostId() int64 {
+func (x *CommentSearchResult) GetRank() float32 {
 	if x != nil {
-		return x.PostId
+		return x.Rank
 	}
 	return 0
 }
 
-func (x *CreateCommentReq) GetBody() string {
+func (x *CommentSearchResult) GetSnippet() string {
 	if x != nil {
-		return x.Body
+		return x.Snippet
 	}
 	return ""
 }
 
-type CreateCommentRsp struct {
+// Results are ordered by rank.
+type SearchCommentsRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Results []*CommentSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *CreateCommentRsp) Reset() {
-	*x = CreateCommentRsp{}
-	mi := &file_api_server_proto_msgTypes[19]
+func (x *SearchCommentsRsp) Reset() {
+	*x = SearchCommentsRsp{}
+	mi := &file_api_server_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentRsp) String() string {
+func (x *SearchCommentsRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentRsp) ProtoMessage() {}
+func (*SearchCommentsRsp) ProtoMessage() {}
 
-func (x *CreateCommentRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[19]
+func (x *SearchCommentsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,43 +4638,41 @@ func (x *CreateCommentRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentRsp.ProtoReflect.Descriptor instead.
-func (*CreateCommentRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SearchCommentsRsp.ProtoReflect.Descriptor instead.
+func (*SearchCommentsRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CreateCommentRsp) GetComment() *Comment {
+func (x *SearchCommentsRsp) GetResults() []*CommentSearchResult {
 	if x != nil {
-		return x.Comment
+		return x.Results
 	}
 	return nil
 }
 
-type EditCommentReq struct {
+type GetUserReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CommentId int64  `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	Body      string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *EditCommentReq) Reset() {
-	*x = EditCommentReq{}
-	mi := &file_api_server_proto_msgTypes[20]
+func (x *GetUserReq) Reset() {
+	*x = GetUserReq{}
+	mi := &file_api_server_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditCommentReq) String() string {
+func (x *GetUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditCommentReq) ProtoMessage() {}
+func (*GetUserReq) ProtoMessage() {}
 
-func (x *EditCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[20]
+func (x *GetUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,55 +4683,41 @@ func (x *EditCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditCommentReq.ProtoReflect.Descriptor instead.
-func (*EditCommentReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *EditCommentReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use GetUserReq.ProtoReflect.Descriptor instead.
+func (*GetUserReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *EditCommentReq) GetCommentId() int64 {
+func (x *GetUserReq) GetId() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *EditCommentReq) GetBody() string {
-	if x != nil {
-		return x.Body
-	}
-	return ""
-}
-
-type EditCommentRsp struct {
+type GetUserRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *EditCommentRsp) Reset() {
-	*x = EditCommentRsp{}
-	mi := &file_api_server_proto_msgTypes[21]
+func (x *GetUserRsp) Reset() {
+	*x = GetUserRsp{}
+	mi := &file_api_server_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EditCommentRsp) String() string {
+func (x *GetUserRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditCommentRsp) ProtoMessage() {}
+func (*GetUserRsp) ProtoMessage() {}
 
-func (x *EditCommentRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[21]
+func (x *GetUserRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,42 +4728,44 @@ func (x *EditCommentRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditCommentRsp.ProtoReflect.Descriptor instead.
-func (*EditCommentRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetUserRsp.ProtoReflect.Descriptor instead.
+func (*GetUserRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *EditCommentRsp) GetComment() *Comment {
+func (x *GetUserRsp) GetUser() *User {
 	if x != nil {
-		return x.Comment
+		return x.User
 	}
 	return nil
 }
 
-type DeleteCommentReq struct {
+// Only fields that are set are updated.
+type UpdateProfileReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Name      *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Bio       *string `protobuf:"bytes,2,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	AvatarUrl *string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
 }
 
-func (x *DeleteCommentReq) Reset() {
-	*x = DeleteCommentReq{}
-	mi := &file_api_server_proto_msgTypes[22]
+func (x *UpdateProfileReq) Reset() {
+	*x = UpdateProfileReq{}
+	mi := &file_api_server_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentReq) String() string {
+func (x *UpdateProfileReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentReq) ProtoMessage() {}
+func (*UpdateProfileReq) ProtoMessage() {}
 
-func (x *DeleteCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[22]
+func (x *UpdateProfileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,46 +4776,55 @@ func (x *DeleteCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentReq.ProtoReflect.Descriptor instead.
-func (*DeleteCommentReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateProfileReq.ProtoReflect.Descriptor instead.
+func (*UpdateProfileReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *DeleteCommentReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
+func (x *UpdateProfileReq) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *DeleteCommentReq) GetCommentId() int64 {
-	if x != nil {
-		return x.CommentId
+func (x *UpdateProfileReq) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
 	}
-	return 0
+	return ""
 }
 
-type DeleteCommentRsp struct {
+func (x *UpdateProfileReq) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+type UpdateProfileRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *DeleteCommentRsp) Reset() {
-	*x = DeleteCommentRsp{}
-	mi := &file_api_server_proto_msgTypes[23]
+func (x *UpdateProfileRsp) Reset() {
+	*x = UpdateProfileRsp{}
+	mi := &file_api_server_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRsp) String() string {
+func (x *UpdateProfileRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRsp) ProtoMessage() {}
+func (*UpdateProfileRsp) ProtoMessage() {}
 
-func (x *DeleteCommentRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[23]
+func (x *UpdateProfileRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,35 +4835,43 @@ func (x *DeleteCommentRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRsp.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateProfileRsp.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{83}
 }
 
-type LikeCommentReq struct {
+func (x *UpdateProfileRsp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ListUserPostsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	AuthorId int64 `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Offset   int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *LikeCommentReq) Reset() {
-	*x = LikeCommentReq{}
-	mi := &file_api_server_proto_msgTypes[24]
+func (x *ListUserPostsReq) Reset() {
+	*x = ListUserPostsReq{}
+	mi := &file_api_server_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentReq) String() string {
+func (x *ListUserPostsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentReq) ProtoMessage() {}
+func (*ListUserPostsReq) ProtoMessage() {}
 
-func (x *LikeCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[24]
+func (x *ListUserPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,46 +4882,55 @@ func (x *LikeCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentReq.ProtoReflect.Descriptor instead.
-func (*LikeCommentReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListUserPostsReq.ProtoReflect.Descriptor instead.
+func (*ListUserPostsReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *LikeCommentReq) GetUserId() int64 {
+func (x *ListUserPostsReq) GetAuthorId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *LikeCommentReq) GetCommentId() int64 {
+func (x *ListUserPostsReq) GetOffset() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.Offset
 	}
 	return 0
 }
 
-type LikeCommentRsp struct {
+func (x *ListUserPostsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUserPostsRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Posts []*Post `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 }
 
-func (x *LikeCommentRsp) Reset() {
-	*x = LikeCommentRsp{}
-	mi := &file_api_server_proto_msgTypes[25]
+func (x *ListUserPostsRsp) Reset() {
+	*x = ListUserPostsRsp{}
+	mi := &file_api_server_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentRsp) String() string {
+func (x *ListUserPostsRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentRsp) ProtoMessage() {}
+func (*ListUserPostsRsp) ProtoMessage() {}
 
-func (x *LikeCommentRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[25]
+func (x *ListUserPostsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1373,35 +4941,43 @@ func (x *LikeCommentRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentRsp.ProtoReflect.Descriptor instead.
-func (*LikeCommentRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListUserPostsRsp.ProtoReflect.Descriptor instead.
+func (*ListUserPostsRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{85}
 }
 
-type DislikeCommentReq struct {
+func (x *ListUserPostsRsp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type ListUserCommentsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CommentId int64 `protobuf:"varint,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	AuthorId int64 `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Offset   int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *DislikeCommentReq) Reset() {
-	*x = DislikeCommentReq{}
-	mi := &file_api_server_proto_msgTypes[26]
+func (x *ListUserCommentsReq) Reset() {
+	*x = ListUserCommentsReq{}
+	mi := &file_api_server_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DislikeCommentReq) String() string {
+func (x *ListUserCommentsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DislikeCommentReq) ProtoMessage() {}
+func (*ListUserCommentsReq) ProtoMessage() {}
 
-func (x *DislikeCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[26]
+func (x *ListUserCommentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,46 +4988,55 @@ func (x *DislikeCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DislikeCommentReq.ProtoReflect.Descriptor instead.
-func (*DislikeCommentReq) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListUserCommentsReq.ProtoReflect.Descriptor instead.
+func (*ListUserCommentsReq) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DislikeCommentReq) GetUserId() int64 {
+func (x *ListUserCommentsReq) GetAuthorId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.AuthorId
 	}
 	return 0
 }
 
-func (x *DislikeCommentReq) GetCommentId() int64 {
+func (x *ListUserCommentsReq) GetOffset() int64 {
 	if x != nil {
-		return x.CommentId
+		return x.Offset
 	}
 	return 0
 }
 
-type DislikeCommentRsp struct {
+func (x *ListUserCommentsReq) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUserCommentsRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Comments []*Comment `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
 }
 
-func (x *DislikeCommentRsp) Reset() {
-	*x = DislikeCommentRsp{}
-	mi := &file_api_server_proto_msgTypes[27]
+func (x *ListUserCommentsRsp) Reset() {
+	*x = ListUserCommentsRsp{}
+	mi := &file_api_server_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DislikeCommentRsp) String() string {
+func (x *ListUserCommentsRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DislikeCommentRsp) ProtoMessage() {}
+func (*ListUserCommentsRsp) ProtoMessage() {}
 
-func (x *DislikeCommentRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_api_server_proto_msgTypes[27]
+func (x *ListUserCommentsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_server_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1462,9 +5047,16 @@ func (x *DislikeCommentRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DislikeCommentRsp.ProtoReflect.Descriptor instead.
-func (*DislikeCommentRsp) Descriptor() ([]byte, []int) {
-	return file_api_server_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListUserCommentsRsp.ProtoReflect.Descriptor instead.
+func (*ListUserCommentsRsp) Descriptor() ([]byte, []int) {
+	return file_api_server_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListUserCommentsRsp) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
 }
 
 var File_api_server_proto protoreflect.FileDescriptor
@@ -1473,189 +5065,752 @@ var file_api_server_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x05, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xb1, 0x01,
-	0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73,
-	0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f,
-	0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
-	0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
-	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0xa0, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
-	0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
-	0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c,
-	0x69, 0x6b, 0x65, 0x64, 0x22, 0x54, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x30, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6f, 0x73,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x0d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73,
-	0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x0d, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x04,
-	0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f,
-	0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x64, 0x0a,
-	0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67,
-	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x70,
-	0x6f, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x73, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70,
-	0x6f, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x3f, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x42, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6c, 0x69,
-	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x44,
-	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x70, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73,
-	0x70, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x58, 0x0a,
-	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x3c, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67,
-	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
-	0x6f, 0x64, 0x79, 0x22, 0x3a, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x4a, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22,
-	0x48, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x6b,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x4b, 0x0a, 0x11, 0x44,
-	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6c,
-	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x32, 0xfb, 0x07,
-	0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
-	0x43, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x12, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x70, 0x6f, 0x73, 0x74,
-	0x73, 0x12, 0x51, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12,
-	0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x17, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d,
-	0x70, 0x6f, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x45, 0x64, 0x69,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f,
-	0x3a, 0x01, 0x2a, 0x1a, 0x0a, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12,
-	0x4e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e,
-	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x0e, 0x2a, 0x0c, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12,
-	0x49, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x67, 0x6f,
-	0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x73, 0x70, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a,
-	0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0b, 0x44, 0x69,
-	0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
-	0x43, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a,
-	0x0d, 0x2f, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x52,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x15, 0x2e,
-	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x15, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x5d, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67,
-	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a,
-	0x22, 0x0f, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x55, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
-	0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x18,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x1a, 0x0d, 0x2f, 0x65, 0x64, 0x69, 0x74,
-	0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
-	0x43, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x17, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f,
-	0x31, 0x43, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73,
-	0x70, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x6c,
-	0x69, 0x6b, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x0e, 0x44,
-	0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e,
-	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
-	0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73,
-	0x70, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x64, 0x69, 0x73, 0x6c,
-	0x69, 0x6b, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0b, 0x5a, 0x09, 0x67,
-	0x6f, 0x5f, 0x31, 0x43, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5b, 0x0a, 0x04,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x55, 0x72, 0x6c, 0x22, 0x46, 0x0a, 0x08, 0x50, 0x6f, 0x73,
+	0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x18, 0x64, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0xdf, 0x03, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x65,
+	0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f,
+	0x31, 0x43, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x52, 0x07, 0x6c, 0x69, 0x6b, 0x65,
+	0x64, 0x42, 0x79, 0x22, 0x46, 0x0a, 0x07, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x23,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67,
+	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x73, 0x22, 0x8a, 0x04, 0x0a, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x12, 0x27, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6b, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x70,
+	0x6c, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x65,
+	0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x62, 0x0a, 0x05, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x75, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1f,
+	0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x67,
+	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x22,
+	0x8f, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x5f,
+	0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x61, 0x73, 0x5f, 0x6d,
+	0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65,
+	0x73, 0x22, 0x7e, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x70, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xd7, 0x02, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x23, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52,
+	0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x58, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f,
+	0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x42, 0x6f, 0x64, 0x79, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x22, 0x30, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x22, 0x78, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79,
+	0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x08, 0x01, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x2e,
+	0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x12, 0x1f, 0x0a,
+	0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f,
+	0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x4d,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2,
+	0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x0f, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x31,
+	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x22, 0x31, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x22, 0x74, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x46, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70, 0x12,
+	0x31, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x74,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28, 0x64, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x3a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x4e, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64,
+	0x22, 0x10, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x73, 0x70, 0x22, 0xad, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x64, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x73, 0x70, 0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x00, 0x28,
+	0x0a, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x73, 0x70, 0x12,
+	0x2e, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2,
+	0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18,
+	0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x32, 0x0a, 0x11, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x0f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3c, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73,
+	0x70, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x70, 0x0a, 0x0e, 0x45,
+	0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x3a, 0x0a,
+	0x0e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x12,
+	0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x3a, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x3d, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x7d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3,
+	0x18, 0x02, 0x20, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08,
+	0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x4c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x5f,
+	0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x78, 0x0a,
+	0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x20, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x18, 0x20, 0x52, 0x08, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x7a, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18,
+	0x04, 0x10, 0x01, 0x18, 0x20, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3c, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52, 0x73, 0x70, 0x12, 0x2e, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x72, 0x0a,
+	0x07, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20,
+	0x00, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2,
+	0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x76, 0x6f, 0x74,
+	0x65, 0x22, 0x2d, 0x0a, 0x07, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x67, 0x6f,
+	0x5f, 0x31, 0x43, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x22, 0x54, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x7d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28, 0x64,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3d, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x57, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3,
+	0x18, 0x02, 0x20, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x13, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x73, 0x70, 0x22, 0x34, 0x0a, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x12, 0x27, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x0a,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x49, 0x64, 0x22, 0x0b, 0x0a, 0x09, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x73, 0x70, 0x22, 0x36, 0x0a, 0x0b, 0x55, 0x6e, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18,
+	0x02, 0x20, 0x01, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x65, 0x49, 0x64, 0x22,
+	0x0d, 0x0a, 0x0b, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x73, 0x70, 0x22, 0x6a,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06,
+	0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x39, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x12, 0x25,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x6a, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x39, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x4b, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x52, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x49, 0x6e, 0x22, 0x6f, 0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x05, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x22, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x08, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x0b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22,
+	0x4c, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x05, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x10, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x56, 0x0a,
+	0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x25,
+	0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x06, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x38, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x2b, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10,
+	0x01, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x0b, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x73, 0x70, 0x22, 0x3e, 0x0a, 0x0f,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x2b, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x38, 0x0a, 0x0f,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x20, 0x00, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3,
+	0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8f, 0x01, 0x0a,
+	0x10, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f,
+	0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6f, 0x64, 0x79, 0x5f, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x53, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0x43,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70,
+	0x12, 0x31, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x10, 0x01,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02,
+	0x20, 0x00, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x07,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2,
+	0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2,
+	0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6d, 0x0a,
+	0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x22, 0x49, 0x0a, 0x11,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73,
+	0x70, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x24, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
+	0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x99, 0x01, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x21, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xc2, 0xf3, 0x18, 0x04, 0x10, 0x01, 0x18, 0x32, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0a, 0x61,
+	0x76, 0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xc2, 0xf3, 0x18, 0x03, 0x18, 0xff, 0x01, 0x48, 0x02, 0x52, 0x09, 0x61, 0x76, 0x61, 0x74,
+	0x61, 0x72, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x62, 0x69, 0x6f, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x61, 0x76,
+	0x61, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x22, 0x33, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x12, 0x1f, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f,
+	0x31, 0x43, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x77, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x23, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52, 0x08, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x00, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01, 0x28, 0x64, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x35, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x21, 0x0a, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
+	0x43, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x7a, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20, 0x01, 0x52,
+	0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xc2, 0xf3, 0x18, 0x02, 0x20,
+	0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0xc2, 0xf3, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x64, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x41, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70,
+	0x12, 0x2a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x31, 0x0a, 0x04,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x2a,
+	0xb4, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x15,
+	0x50, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x4f, 0x53, 0x54, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53,
+	0x54, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x4c, 0x49, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x50, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4d, 0x4f, 0x53, 0x54, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f,
+	0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x48, 0x4f, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a,
+	0x13, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x53,
+	0x43, 0x4f, 0x52, 0x45, 0x10, 0x06, 0x32, 0xb8, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a,
+	0x2f, 0x67, 0x65, 0x74, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x73, 0x70, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22,
+	0x0c, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x49, 0x0a,
+	0x08, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
+	0x43, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73,
+	0x70, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x1a, 0x0a, 0x2f, 0x65,
+	0x64, 0x69, 0x74, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x67,
+	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x73, 0x70, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x2a, 0x0c, 0x2f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x6b,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x15, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x2d, 0x70,
+	0x6f, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69,
+	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
+	0x43, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x73, 0x70,
+	0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x2a, 0x0d, 0x2f, 0x64, 0x69, 0x73, 0x6c, 0x69,
+	0x6b, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31,
+	0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x19, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x70, 0x6f, 0x73,
+	0x74, 0x2d, 0x6c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x73, 0x70, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a,
+	0x22, 0x0d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x12,
+	0x5f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x67, 0x6f,
+	0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x73, 0x70, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f,
+	0x67, 0x65, 0x74, 0x2d, 0x70, 0x6f, 0x73, 0x74, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x15, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x67, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x73, 0x70,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x5d, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x55, 0x0a, 0x0b,
+	0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x67, 0x6f,
+	0x5f, 0x31, 0x43, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x3a, 0x01, 0x2a, 0x1a, 0x0d, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x2d, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
+	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f,
+	0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x55, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x18, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x2d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x44, 0x69, 0x73, 0x6c, 0x69,
+	0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73, 0x70, 0x22, 0x18, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x12, 0x2a, 0x10, 0x2f, 0x64, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65, 0x2d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x63, 0x74, 0x12,
+	0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x73,
+	0x70, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22, 0x06, 0x2f, 0x72,
+	0x65, 0x61, 0x63, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x12,
+	0x11, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x11, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x52, 0x73, 0x70, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x2a, 0x08, 0x2f,
+	0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12,
+	0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x0e, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22,
+	0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x3a, 0x01, 0x2a, 0x22, 0x05, 0x2f, 0x76, 0x6f, 0x74,
+	0x65, 0x12, 0x6b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x73, 0x70,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x6c, 0x69, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x61,
+	0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x67, 0x6f, 0x5f,
+	0x31, 0x43, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x73, 0x70, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22,
+	0x10, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x6b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x52,
+	0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x15, 0x2e,
+	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x15, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x5e, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
+	0x12, 0x10, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x11, 0x2e,
+	0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x73, 0x70, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x67, 0x65,
+	0x74, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x3a, 0x01, 0x2a, 0x1a, 0x0f, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x2d, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11,
+	0x12, 0x0f, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x12, 0x66, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1a, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x75, 0x73, 0x65, 0x72,
+	0x2d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x12, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x73, 0x70, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a,
+	0x01, 0x2a, 0x22, 0x07, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x45, 0x0a, 0x08, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67, 0x6f,
+	0x5f, 0x31, 0x43, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x73, 0x70, 0x22,
+	0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x2a, 0x09, 0x2f, 0x75, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x12, 0x59, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67,
+	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x52, 0x73, 0x70, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f,
+	0x67, 0x65, 0x74, 0x2d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x59, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x17,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70,
+	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46,
+	0x65, 0x65, 0x64, 0x12, 0x11, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x52, 0x73, 0x70, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0b, 0x12, 0x09, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x66, 0x65, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x08,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x67,
+	0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x73, 0x70,
+	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x0f, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x73,
+	0x70, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x3a, 0x01, 0x2a, 0x22, 0x06, 0x2f, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x40, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x10,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x52,
+	0x73, 0x70, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x3a, 0x01, 0x2a, 0x22, 0x07, 0x2f,
+	0x6c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x59, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x73, 0x70, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01,
+	0x2a, 0x22, 0x0e, 0x2f, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x2d, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x42, 0x0b, 0x5a, 0x09, 0x67, 0x6f, 0x5f, 0x31, 0x43, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1670,78 +5825,242 @@ func file_api_server_proto_rawDescGZIP() []byte {
 	return file_api_server_proto_rawDescData
 }
 
-var file_api_server_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_api_server_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_server_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_api_server_proto_goTypes = []any{
-	(*UserInfo)(nil),          // 0: go_1C.UserInfo
-	(*PostBody)(nil),          // 1: go_1C.PostBody
-	(*Post)(nil),              // 2: go_1C.Post
-	(*Comment)(nil),           // 3: go_1C.Comment
-	(*GetPostsReq)(nil),       // 4: go_1C.GetPostsReq
-	(*GetPostsRsp)(nil),       // 5: go_1C.GetPostsRsp
-	(*CreatePostReq)(nil),     // 6: go_1C.CreatePostReq
-	(*CreatePostRsp)(nil),     // 7: go_1C.CreatePostRsp
-	(*EditPostReq)(nil),       // 8: go_1C.EditPostReq
-	(*EditPostRsp)(nil),       // 9: go_1C.EditPostRsp
-	(*DeletePostReq)(nil),     // 10: go_1C.DeletePostReq
-	(*DeletePostRsp)(nil),     // 11: go_1C.DeletePostRsp
-	(*LikePostReq)(nil),       // 12: go_1C.LikePostReq
-	(*LikePostRsp)(nil),       // 13: go_1C.LikePostRsp
-	(*DislikePostReq)(nil),    // 14: go_1C.DislikePostReq
-	(*DislikePostRsp)(nil),    // 15: go_1C.DislikePostRsp
-	(*GetCommentsReq)(nil),    // 16: go_1C.GetCommentsReq
-	(*GetCommentsRsp)(nil),    // 17: go_1C.GetCommentsRsp
-	(*CreateCommentReq)(nil),  // 18: go_1C.CreateCommentReq
-	(*CreateCommentRsp)(nil),  // 19: go_1C.CreateCommentRsp
-	(*EditCommentReq)(nil),    // 20: go_1C.EditCommentReq
-	(*EditCommentRsp)(nil),    // 21: go_1C.EditCommentRsp
-	(*DeleteCommentReq)(nil),  // 22: go_1C.DeleteCommentReq
-	(*DeleteCommentRsp)(nil),  // 23: go_1C.DeleteCommentRsp
-	(*LikeCommentReq)(nil),    // 24: go_1C.LikeCommentReq
-	(*LikeCommentRsp)(nil),    // 25: go_1C.LikeCommentRsp
-	(*DislikeCommentReq)(nil), // 26: go_1C.DislikeCommentReq
-	(*DislikeCommentRsp)(nil), // 27: go_1C.DislikeCommentRsp
+	(Vote)(0),                     // 0: go_1C.Vote
+	(PostSort)(0),                 // 1: go_1C.PostSort
+	(*UserInfo)(nil),              // 2: go_1C.UserInfo
+	(*User)(nil),                  // 3: go_1C.User
+	(*PostBody)(nil),              // 4: go_1C.PostBody
+	(*Post)(nil),                  // 5: go_1C.Post
+	(*LikedBy)(nil),               // 6: go_1C.LikedBy
+	(*Comment)(nil),               // 7: go_1C.Comment
+	(*Reactions)(nil),             // 8: go_1C.Reactions
+	(*Votes)(nil),                 // 9: go_1C.Votes
+	(*CommentNode)(nil),           // 10: go_1C.CommentNode
+	(*PostRevision)(nil),          // 11: go_1C.PostRevision
+	(*CommentRevision)(nil),       // 12: go_1C.CommentRevision
+	(*GetPostsReq)(nil),           // 13: go_1C.GetPostsReq
+	(*GetPostsRsp)(nil),           // 14: go_1C.GetPostsRsp
+	(*CreatePostReq)(nil),         // 15: go_1C.CreatePostReq
+	(*CreatePostRsp)(nil),         // 16: go_1C.CreatePostRsp
+	(*EditPostReq)(nil),           // 17: go_1C.EditPostReq
+	(*EditPostRsp)(nil),           // 18: go_1C.EditPostRsp
+	(*DeletePostReq)(nil),         // 19: go_1C.DeletePostReq
+	(*DeletePostRsp)(nil),         // 20: go_1C.DeletePostRsp
+	(*RestorePostReq)(nil),        // 21: go_1C.RestorePostReq
+	(*RestorePostRsp)(nil),        // 22: go_1C.RestorePostRsp
+	(*GetPostHistoryReq)(nil),     // 23: go_1C.GetPostHistoryReq
+	(*GetPostHistoryRsp)(nil),     // 24: go_1C.GetPostHistoryRsp
+	(*LikePostReq)(nil),           // 25: go_1C.LikePostReq
+	(*LikePostRsp)(nil),           // 26: go_1C.LikePostRsp
+	(*ListPostLikersReq)(nil),     // 27: go_1C.ListPostLikersReq
+	(*ListPostLikersRsp)(nil),     // 28: go_1C.ListPostLikersRsp
+	(*DislikePostReq)(nil),        // 29: go_1C.DislikePostReq
+	(*DislikePostRsp)(nil),        // 30: go_1C.DislikePostRsp
+	(*GetCommentsReq)(nil),        // 31: go_1C.GetCommentsReq
+	(*GetCommentsRsp)(nil),        // 32: go_1C.GetCommentsRsp
+	(*GetCommentThreadReq)(nil),   // 33: go_1C.GetCommentThreadReq
+	(*GetCommentThreadRsp)(nil),   // 34: go_1C.GetCommentThreadRsp
+	(*CreateCommentReq)(nil),      // 35: go_1C.CreateCommentReq
+	(*CreateCommentRsp)(nil),      // 36: go_1C.CreateCommentRsp
+	(*EditCommentReq)(nil),        // 37: go_1C.EditCommentReq
+	(*EditCommentRsp)(nil),        // 38: go_1C.EditCommentRsp
+	(*DeleteCommentReq)(nil),      // 39: go_1C.DeleteCommentReq
+	(*DeleteCommentRsp)(nil),      // 40: go_1C.DeleteCommentRsp
+	(*RestoreCommentReq)(nil),     // 41: go_1C.RestoreCommentReq
+	(*RestoreCommentRsp)(nil),     // 42: go_1C.RestoreCommentRsp
+	(*GetCommentHistoryReq)(nil),  // 43: go_1C.GetCommentHistoryReq
+	(*GetCommentHistoryRsp)(nil),  // 44: go_1C.GetCommentHistoryRsp
+	(*ReactReq)(nil),              // 45: go_1C.ReactReq
+	(*ReactRsp)(nil),              // 46: go_1C.ReactRsp
+	(*UnreactReq)(nil),            // 47: go_1C.UnreactReq
+	(*UnreactRsp)(nil),            // 48: go_1C.UnreactRsp
+	(*VoteReq)(nil),               // 49: go_1C.VoteReq
+	(*VoteRsp)(nil),               // 50: go_1C.VoteRsp
+	(*LikeCommentReq)(nil),        // 51: go_1C.LikeCommentReq
+	(*LikeCommentRsp)(nil),        // 52: go_1C.LikeCommentRsp
+	(*ListCommentLikersReq)(nil),  // 53: go_1C.ListCommentLikersReq
+	(*ListCommentLikersRsp)(nil),  // 54: go_1C.ListCommentLikersRsp
+	(*DislikeCommentReq)(nil),     // 55: go_1C.DislikeCommentReq
+	(*DislikeCommentRsp)(nil),     // 56: go_1C.DislikeCommentRsp
+	(*FollowReq)(nil),             // 57: go_1C.FollowReq
+	(*FollowRsp)(nil),             // 58: go_1C.FollowRsp
+	(*UnfollowReq)(nil),           // 59: go_1C.UnfollowReq
+	(*UnfollowRsp)(nil),           // 60: go_1C.UnfollowRsp
+	(*ListFollowersReq)(nil),      // 61: go_1C.ListFollowersReq
+	(*ListFollowersRsp)(nil),      // 62: go_1C.ListFollowersRsp
+	(*ListFollowingReq)(nil),      // 63: go_1C.ListFollowingReq
+	(*ListFollowingRsp)(nil),      // 64: go_1C.ListFollowingRsp
+	(*GetFeedReq)(nil),            // 65: go_1C.GetFeedReq
+	(*GetFeedRsp)(nil),            // 66: go_1C.GetFeedRsp
+	(*Tokens)(nil),                // 67: go_1C.Tokens
+	(*RegisterReq)(nil),           // 68: go_1C.RegisterReq
+	(*RegisterRsp)(nil),           // 69: go_1C.RegisterRsp
+	(*LoginReq)(nil),              // 70: go_1C.LoginReq
+	(*LoginRsp)(nil),              // 71: go_1C.LoginRsp
+	(*LogoutReq)(nil),             // 72: go_1C.LogoutReq
+	(*LogoutRsp)(nil),             // 73: go_1C.LogoutRsp
+	(*RefreshTokenReq)(nil),       // 74: go_1C.RefreshTokenReq
+	(*RefreshTokenRsp)(nil),       // 75: go_1C.RefreshTokenRsp
+	(*SearchPostsReq)(nil),        // 76: go_1C.SearchPostsReq
+	(*PostSearchResult)(nil),      // 77: go_1C.PostSearchResult
+	(*SearchPostsRsp)(nil),        // 78: go_1C.SearchPostsRsp
+	(*SearchCommentsReq)(nil),     // 79: go_1C.SearchCommentsReq
+	(*CommentSearchResult)(nil),   // 80: go_1C.CommentSearchResult
+	(*SearchCommentsRsp)(nil),     // 81: go_1C.SearchCommentsRsp
+	(*GetUserReq)(nil),            // 82: go_1C.GetUserReq
+	(*GetUserRsp)(nil),            // 83: go_1C.GetUserRsp
+	(*UpdateProfileReq)(nil),      // 84: go_1C.UpdateProfileReq
+	(*UpdateProfileRsp)(nil),      // 85: go_1C.UpdateProfileRsp
+	(*ListUserPostsReq)(nil),      // 86: go_1C.ListUserPostsReq
+	(*ListUserPostsRsp)(nil),      // 87: go_1C.ListUserPostsRsp
+	(*ListUserCommentsReq)(nil),   // 88: go_1C.ListUserCommentsReq
+	(*ListUserCommentsRsp)(nil),   // 89: go_1C.ListUserCommentsRsp
+	nil,                           // 90: go_1C.Reactions.CountsEntry
+	(*timestamppb.Timestamp)(nil), // 91: google.protobuf.Timestamp
 }
 var file_api_server_proto_depIdxs = []int32{
-	1,  // 0: go_1C.Post.post:type_name -> go_1C.PostBody
-	0,  // 1: go_1C.Post.author:type_name -> go_1C.UserInfo
-	0,  // 2: go_1C.Comment.author:type_name -> go_1C.UserInfo
-	2,  // 3: go_1C.GetPostsRsp.posts:type_name -> go_1C.Post
-	1,  // 4: go_1C.CreatePostReq.post:type_name -> go_1C.PostBody
-	2,  // 5: go_1C.CreatePostRsp.post:type_name -> go_1C.Post
-	1,  // 6: go_1C.EditPostReq.post:type_name -> go_1C.PostBody
-	2,  // 7: go_1C.EditPostRsp.post:type_name -> go_1C.Post
-	3,  // 8: go_1C.GetCommentsRsp.comments:type_name -> go_1C.Comment
-	3,  // 9: go_1C.CreateCommentRsp.comment:type_name -> go_1C.Comment
-	3,  // 10: go_1C.EditCommentRsp.comment:type_name -> go_1C.Comment
-	4,  // 11: go_1C.Service.GetPosts:input_type -> go_1C.GetPostsReq
-	6,  // 12: go_1C.Service.CreatePost:input_type -> go_1C.CreatePostReq
-	8,  // 13: go_1C.Service.EditPost:input_type -> go_1C.EditPostReq
-	10, // 14: go_1C.Service.DeletePost:input_type -> go_1C.DeletePostReq
-	12, // 15: go_1C.Service.LikePost:input_type -> go_1C.LikePostReq
-	14, // 16: go_1C.Service.DislikePost:input_type -> go_1C.DislikePostReq
-	16, // 17: go_1C.Service.GetComments:input_type -> go_1C.GetCommentsReq
-	18, // 18: go_1C.Service.CreateComment:input_type -> go_1C.CreateCommentReq
-	20, // 19: go_1C.Service.EditComment:input_type -> go_1C.EditCommentReq
-	22, // 20: go_1C.Service.DeleteComment:input_type -> go_1C.DeleteCommentReq
-	24, // 21: go_1C.Service.LikeComment:input_type -> go_1C.LikeCommentReq
-	26, // 22: go_1C.Service.DislikeComment:input_type -> go_1C.DislikeCommentReq
-	5,  // 23: go_1C.Service.GetPosts:output_type -> go_1C.GetPostsRsp
-	7,  // 24: go_1C.Service.CreatePost:output_type -> go_1C.CreatePostRsp
-	9,  // 25: go_1C.Service.EditPost:output_type -> go_1C.EditPostRsp
-	11, // 26: go_1C.Service.DeletePost:output_type -> go_1C.DeletePostRsp
-	13, // 27: go_1C.Service.LikePost:output_type -> go_1C.LikePostRsp
-	15, // 28: go_1C.Service.DislikePost:output_type -> go_1C.DislikePostRsp
-	17, // 29: go_1C.Service.GetComments:output_type -> go_1C.GetCommentsRsp
-	19, // 30: go_1C.Service.CreateComment:output_type -> go_1C.CreateCommentRsp
-	21, // 31: go_1C.Service.EditComment:output_type -> go_1C.EditCommentRsp
-	23, // 32: go_1C.Service.DeleteComment:output_type -> go_1C.DeleteCommentRsp
-	25, // 33: go_1C.Service.LikeComment:output_type -> go_1C.LikeCommentRsp
-	27, // 34: go_1C.Service.DislikeComment:output_type -> go_1C.DislikeCommentRsp
-	23, // [23:35] is the sub-list for method output_type
-	11, // [11:23] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	4,  // 0: go_1C.Post.post:type_name -> go_1C.PostBody
+	2,  // 1: go_1C.Post.author:type_name -> go_1C.UserInfo
+	91, // 2: go_1C.Post.created_at:type_name -> google.protobuf.Timestamp
+	91, // 3: go_1C.Post.updated_at:type_name -> google.protobuf.Timestamp
+	91, // 4: go_1C.Post.edited_at:type_name -> google.protobuf.Timestamp
+	8,  // 5: go_1C.Post.reactions:type_name -> go_1C.Reactions
+	9,  // 6: go_1C.Post.votes:type_name -> go_1C.Votes
+	6,  // 7: go_1C.Post.liked_by:type_name -> go_1C.LikedBy
+	2,  // 8: go_1C.LikedBy.user:type_name -> go_1C.UserInfo
+	2,  // 9: go_1C.Comment.author:type_name -> go_1C.UserInfo
+	91, // 10: go_1C.Comment.created_at:type_name -> google.protobuf.Timestamp
+	91, // 11: go_1C.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	91, // 12: go_1C.Comment.edited_at:type_name -> google.protobuf.Timestamp
+	8,  // 13: go_1C.Comment.reactions:type_name -> go_1C.Reactions
+	9,  // 14: go_1C.Comment.votes:type_name -> go_1C.Votes
+	90, // 15: go_1C.Reactions.counts:type_name -> go_1C.Reactions.CountsEntry
+	0,  // 16: go_1C.Votes.mine:type_name -> go_1C.Vote
+	7,  // 17: go_1C.CommentNode.comment:type_name -> go_1C.Comment
+	10, // 18: go_1C.CommentNode.replies:type_name -> go_1C.CommentNode
+	4,  // 19: go_1C.PostRevision.post:type_name -> go_1C.PostBody
+	91, // 20: go_1C.PostRevision.created_at:type_name -> google.protobuf.Timestamp
+	91, // 21: go_1C.CommentRevision.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 22: go_1C.GetPostsReq.sort:type_name -> go_1C.PostSort
+	91, // 23: go_1C.GetPostsReq.created_after:type_name -> google.protobuf.Timestamp
+	91, // 24: go_1C.GetPostsReq.created_before:type_name -> google.protobuf.Timestamp
+	5,  // 25: go_1C.GetPostsRsp.posts:type_name -> go_1C.Post
+	4,  // 26: go_1C.CreatePostReq.post:type_name -> go_1C.PostBody
+	5,  // 27: go_1C.CreatePostRsp.post:type_name -> go_1C.Post
+	4,  // 28: go_1C.EditPostReq.post:type_name -> go_1C.PostBody
+	5,  // 29: go_1C.EditPostRsp.post:type_name -> go_1C.Post
+	5,  // 30: go_1C.RestorePostRsp.post:type_name -> go_1C.Post
+	11, // 31: go_1C.GetPostHistoryRsp.revisions:type_name -> go_1C.PostRevision
+	2,  // 32: go_1C.ListPostLikersRsp.users:type_name -> go_1C.UserInfo
+	7,  // 33: go_1C.GetCommentsRsp.comments:type_name -> go_1C.Comment
+	10, // 34: go_1C.GetCommentThreadRsp.comments:type_name -> go_1C.CommentNode
+	7,  // 35: go_1C.CreateCommentRsp.comment:type_name -> go_1C.Comment
+	7,  // 36: go_1C.EditCommentRsp.comment:type_name -> go_1C.Comment
+	7,  // 37: go_1C.RestoreCommentRsp.comment:type_name -> go_1C.Comment
+	12, // 38: go_1C.GetCommentHistoryRsp.revisions:type_name -> go_1C.CommentRevision
+	8,  // 39: go_1C.ReactRsp.reactions:type_name -> go_1C.Reactions
+	8,  // 40: go_1C.UnreactRsp.reactions:type_name -> go_1C.Reactions
+	0,  // 41: go_1C.VoteReq.vote:type_name -> go_1C.Vote
+	9,  // 42: go_1C.VoteRsp.votes:type_name -> go_1C.Votes
+	2,  // 43: go_1C.ListCommentLikersRsp.users:type_name -> go_1C.UserInfo
+	2,  // 44: go_1C.ListFollowersRsp.users:type_name -> go_1C.UserInfo
+	2,  // 45: go_1C.ListFollowingRsp.users:type_name -> go_1C.UserInfo
+	5,  // 46: go_1C.GetFeedRsp.posts:type_name -> go_1C.Post
+	2,  // 47: go_1C.RegisterRsp.user:type_name -> go_1C.UserInfo
+	67, // 48: go_1C.RegisterRsp.tokens:type_name -> go_1C.Tokens
+	2,  // 49: go_1C.LoginRsp.user:type_name -> go_1C.UserInfo
+	67, // 50: go_1C.LoginRsp.tokens:type_name -> go_1C.Tokens
+	67, // 51: go_1C.RefreshTokenRsp.tokens:type_name -> go_1C.Tokens
+	5,  // 52: go_1C.PostSearchResult.post:type_name -> go_1C.Post
+	77, // 53: go_1C.SearchPostsRsp.results:type_name -> go_1C.PostSearchResult
+	7,  // 54: go_1C.CommentSearchResult.comment:type_name -> go_1C.Comment
+	80, // 55: go_1C.SearchCommentsRsp.results:type_name -> go_1C.CommentSearchResult
+	3,  // 56: go_1C.GetUserRsp.user:type_name -> go_1C.User
+	3,  // 57: go_1C.UpdateProfileRsp.user:type_name -> go_1C.User
+	5,  // 58: go_1C.ListUserPostsRsp.posts:type_name -> go_1C.Post
+	7,  // 59: go_1C.ListUserCommentsRsp.comments:type_name -> go_1C.Comment
+	13, // 60: go_1C.Service.GetPosts:input_type -> go_1C.GetPostsReq
+	15, // 61: go_1C.Service.CreatePost:input_type -> go_1C.CreatePostReq
+	17, // 62: go_1C.Service.EditPost:input_type -> go_1C.EditPostReq
+	19, // 63: go_1C.Service.DeletePost:input_type -> go_1C.DeletePostReq
+	25, // 64: go_1C.Service.LikePost:input_type -> go_1C.LikePostReq
+	29, // 65: go_1C.Service.DislikePost:input_type -> go_1C.DislikePostReq
+	27, // 66: go_1C.Service.ListPostLikers:input_type -> go_1C.ListPostLikersReq
+	21, // 67: go_1C.Service.RestorePost:input_type -> go_1C.RestorePostReq
+	23, // 68: go_1C.Service.GetPostHistory:input_type -> go_1C.GetPostHistoryReq
+	31, // 69: go_1C.Service.GetComments:input_type -> go_1C.GetCommentsReq
+	33, // 70: go_1C.Service.GetCommentThread:input_type -> go_1C.GetCommentThreadReq
+	35, // 71: go_1C.Service.CreateComment:input_type -> go_1C.CreateCommentReq
+	37, // 72: go_1C.Service.EditComment:input_type -> go_1C.EditCommentReq
+	39, // 73: go_1C.Service.DeleteComment:input_type -> go_1C.DeleteCommentReq
+	51, // 74: go_1C.Service.LikeComment:input_type -> go_1C.LikeCommentReq
+	55, // 75: go_1C.Service.DislikeComment:input_type -> go_1C.DislikeCommentReq
+	45, // 76: go_1C.Service.React:input_type -> go_1C.ReactReq
+	47, // 77: go_1C.Service.Unreact:input_type -> go_1C.UnreactReq
+	49, // 78: go_1C.Service.Vote:input_type -> go_1C.VoteReq
+	53, // 79: go_1C.Service.ListCommentLikers:input_type -> go_1C.ListCommentLikersReq
+	41, // 80: go_1C.Service.RestoreComment:input_type -> go_1C.RestoreCommentReq
+	43, // 81: go_1C.Service.GetCommentHistory:input_type -> go_1C.GetCommentHistoryReq
+	76, // 82: go_1C.Service.SearchPosts:input_type -> go_1C.SearchPostsReq
+	79, // 83: go_1C.Service.SearchComments:input_type -> go_1C.SearchCommentsReq
+	82, // 84: go_1C.Service.GetUser:input_type -> go_1C.GetUserReq
+	84, // 85: go_1C.Service.UpdateProfile:input_type -> go_1C.UpdateProfileReq
+	86, // 86: go_1C.Service.ListUserPosts:input_type -> go_1C.ListUserPostsReq
+	88, // 87: go_1C.Service.ListUserComments:input_type -> go_1C.ListUserCommentsReq
+	57, // 88: go_1C.Service.Follow:input_type -> go_1C.FollowReq
+	59, // 89: go_1C.Service.Unfollow:input_type -> go_1C.UnfollowReq
+	61, // 90: go_1C.Service.ListFollowers:input_type -> go_1C.ListFollowersReq
+	63, // 91: go_1C.Service.ListFollowing:input_type -> go_1C.ListFollowingReq
+	65, // 92: go_1C.Service.GetFeed:input_type -> go_1C.GetFeedReq
+	68, // 93: go_1C.Service.Register:input_type -> go_1C.RegisterReq
+	70, // 94: go_1C.Service.Login:input_type -> go_1C.LoginReq
+	72, // 95: go_1C.Service.Logout:input_type -> go_1C.LogoutReq
+	74, // 96: go_1C.Service.RefreshToken:input_type -> go_1C.RefreshTokenReq
+	14, // 97: go_1C.Service.GetPosts:output_type -> go_1C.GetPostsRsp
+	16, // 98: go_1C.Service.CreatePost:output_type -> go_1C.CreatePostRsp
+	18, // 99: go_1C.Service.EditPost:output_type -> go_1C.EditPostRsp
+	20, // 100: go_1C.Service.DeletePost:output_type -> go_1C.DeletePostRsp
+	26, // 101: go_1C.Service.LikePost:output_type -> go_1C.LikePostRsp
+	30, // 102: go_1C.Service.DislikePost:output_type -> go_1C.DislikePostRsp
+	28, // 103: go_1C.Service.ListPostLikers:output_type -> go_1C.ListPostLikersRsp
+	22, // 104: go_1C.Service.RestorePost:output_type -> go_1C.RestorePostRsp
+	24, // 105: go_1C.Service.GetPostHistory:output_type -> go_1C.GetPostHistoryRsp
+	32, // 106: go_1C.Service.GetComments:output_type -> go_1C.GetCommentsRsp
+	34, // 107: go_1C.Service.GetCommentThread:output_type -> go_1C.GetCommentThreadRsp
+	36, // 108: go_1C.Service.CreateComment:output_type -> go_1C.CreateCommentRsp
+	38, // 109: go_1C.Service.EditComment:output_type -> go_1C.EditCommentRsp
+	40, // 110: go_1C.Service.DeleteComment:output_type -> go_1C.DeleteCommentRsp
+	52, // 111: go_1C.Service.LikeComment:output_type -> go_1C.LikeCommentRsp
+	56, // 112: go_1C.Service.DislikeComment:output_type -> go_1C.DislikeCommentRsp
+	46, // 113: go_1C.Service.React:output_type -> go_1C.ReactRsp
+	48, // 114: go_1C.Service.Unreact:output_type -> go_1C.UnreactRsp
+	50, // 115: go_1C.Service.Vote:output_type -> go_1C.VoteRsp
+	54, // 116: go_1C.Service.ListCommentLikers:output_type -> go_1C.ListCommentLikersRsp
+	42, // 117: go_1C.Service.RestoreComment:output_type -> go_1C.RestoreCommentRsp
+	44, // 118: go_1C.Service.GetCommentHistory:output_type -> go_1C.GetCommentHistoryRsp
+	78, // 119: go_1C.Service.SearchPosts:output_type -> go_1C.SearchPostsRsp
+	81, // 120: go_1C.Service.SearchComments:output_type -> go_1C.SearchCommentsRsp
+	83, // 121: go_1C.Service.GetUser:output_type -> go_1C.GetUserRsp
+	85, // 122: go_1C.Service.UpdateProfile:output_type -> go_1C.UpdateProfileRsp
+	87, // 123: go_1C.Service.ListUserPosts:output_type -> go_1C.ListUserPostsRsp
+	89, // 124: go_1C.Service.ListUserComments:output_type -> go_1C.ListUserCommentsRsp
+	58, // 125: go_1C.Service.Follow:output_type -> go_1C.FollowRsp
+	60, // 126: go_1C.Service.Unfollow:output_type -> go_1C.UnfollowRsp
+	62, // 127: go_1C.Service.ListFollowers:output_type -> go_1C.ListFollowersRsp
+	64, // 128: go_1C.Service.ListFollowing:output_type -> go_1C.ListFollowingRsp
+	66, // 129: go_1C.Service.GetFeed:output_type -> go_1C.GetFeedRsp
+	69, // 130: go_1C.Service.Register:output_type -> go_1C.RegisterRsp
+	71, // 131: go_1C.Service.Login:output_type -> go_1C.LoginRsp
+	73, // 132: go_1C.Service.Logout:output_type -> go_1C.LogoutRsp
+	75, // 133: go_1C.Service.RefreshToken:output_type -> go_1C.RefreshTokenRsp
+	97, // [97:134] is the sub-list for method output_type
+	60, // [60:97] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_api_server_proto_init() }
@@ -1749,18 +6068,21 @@ func file_api_server_proto_init() {
 	if File_api_server_proto != nil {
 		return
 	}
+	file_api_validate_proto_init()
+	file_api_server_proto_msgTypes[82].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_server_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   28,
+			NumEnums:      2,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_server_proto_goTypes,
 		DependencyIndexes: file_api_server_proto_depIdxs,
+		EnumInfos:         file_api_server_proto_enumTypes,
 		MessageInfos:      file_api_server_proto_msgTypes,
 	}.Build()
 	File_api_server_proto = out.File
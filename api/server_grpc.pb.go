@@ -19,18 +19,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Service_GetPosts_FullMethodName       = "/go_1C.Service/GetPosts"
-	Service_CreatePost_FullMethodName     = "/go_1C.Service/CreatePost"
-	Service_EditPost_FullMethodName       = "/go_1C.Service/EditPost"
-	Service_DeletePost_FullMethodName     = "/go_1C.Service/DeletePost"
-	Service_LikePost_FullMethodName       = "/go_1C.Service/LikePost"
-	Service_DislikePost_FullMethodName    = "/go_1C.Service/DislikePost"
-	Service_GetComments_FullMethodName    = "/go_1C.Service/GetComments"
-	Service_CreateComment_FullMethodName  = "/go_1C.Service/CreateComment"
-	Service_EditComment_FullMethodName    = "/go_1C.Service/EditComment"
-	Service_DeleteComment_FullMethodName  = "/go_1C.Service/DeleteComment"
-	Service_LikeComment_FullMethodName    = "/go_1C.Service/LikeComment"
-	Service_DislikeComment_FullMethodName = "/go_1C.Service/DislikeComment"
+	Service_GetPosts_FullMethodName          = "/go_1C.Service/GetPosts"
+	Service_CreatePost_FullMethodName        = "/go_1C.Service/CreatePost"
+	Service_EditPost_FullMethodName          = "/go_1C.Service/EditPost"
+	Service_DeletePost_FullMethodName        = "/go_1C.Service/DeletePost"
+	Service_LikePost_FullMethodName          = "/go_1C.Service/LikePost"
+	Service_DislikePost_FullMethodName       = "/go_1C.Service/DislikePost"
+	Service_ListPostLikers_FullMethodName    = "/go_1C.Service/ListPostLikers"
+	Service_RestorePost_FullMethodName       = "/go_1C.Service/RestorePost"
+	Service_GetPostHistory_FullMethodName    = "/go_1C.Service/GetPostHistory"
+	Service_GetComments_FullMethodName       = "/go_1C.Service/GetComments"
+	Service_GetCommentThread_FullMethodName  = "/go_1C.Service/GetCommentThread"
+	Service_CreateComment_FullMethodName     = "/go_1C.Service/CreateComment"
+	Service_EditComment_FullMethodName       = "/go_1C.Service/EditComment"
+	Service_DeleteComment_FullMethodName     = "/go_1C.Service/DeleteComment"
+	Service_LikeComment_FullMethodName       = "/go_1C.Service/LikeComment"
+	Service_DislikeComment_FullMethodName    = "/go_1C.Service/DislikeComment"
+	Service_React_FullMethodName             = "/go_1C.Service/React"
+	Service_Unreact_FullMethodName           = "/go_1C.Service/Unreact"
+	Service_Vote_FullMethodName              = "/go_1C.Service/Vote"
+	Service_ListCommentLikers_FullMethodName = "/go_1C.Service/ListCommentLikers"
+	Service_RestoreComment_FullMethodName    = "/go_1C.Service/RestoreComment"
+	Service_GetCommentHistory_FullMethodName = "/go_1C.Service/GetCommentHistory"
+	Service_SearchPosts_FullMethodName       = "/go_1C.Service/SearchPosts"
+	Service_SearchComments_FullMethodName    = "/go_1C.Service/SearchComments"
+	Service_GetUser_FullMethodName           = "/go_1C.Service/GetUser"
+	Service_UpdateProfile_FullMethodName     = "/go_1C.Service/UpdateProfile"
+	Service_ListUserPosts_FullMethodName     = "/go_1C.Service/ListUserPosts"
+	Service_ListUserComments_FullMethodName  = "/go_1C.Service/ListUserComments"
+	Service_Follow_FullMethodName            = "/go_1C.Service/Follow"
+	Service_Unfollow_FullMethodName          = "/go_1C.Service/Unfollow"
+	Service_ListFollowers_FullMethodName     = "/go_1C.Service/ListFollowers"
+	Service_ListFollowing_FullMethodName     = "/go_1C.Service/ListFollowing"
+	Service_GetFeed_FullMethodName           = "/go_1C.Service/GetFeed"
+	Service_Register_FullMethodName          = "/go_1C.Service/Register"
+	Service_Login_FullMethodName             = "/go_1C.Service/Login"
+	Service_Logout_FullMethodName            = "/go_1C.Service/Logout"
+	Service_RefreshToken_FullMethodName      = "/go_1C.Service/RefreshToken"
 )
 
 // ServiceClient is the client API for Service service.
@@ -43,12 +68,37 @@ type ServiceClient interface {
 	DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostRsp, error)
 	LikePost(ctx context.Context, in *LikePostReq, opts ...grpc.CallOption) (*LikePostRsp, error)
 	DislikePost(ctx context.Context, in *DislikePostReq, opts ...grpc.CallOption) (*DislikePostRsp, error)
+	ListPostLikers(ctx context.Context, in *ListPostLikersReq, opts ...grpc.CallOption) (*ListPostLikersRsp, error)
+	RestorePost(ctx context.Context, in *RestorePostReq, opts ...grpc.CallOption) (*RestorePostRsp, error)
+	GetPostHistory(ctx context.Context, in *GetPostHistoryReq, opts ...grpc.CallOption) (*GetPostHistoryRsp, error)
 	GetComments(ctx context.Context, in *GetCommentsReq, opts ...grpc.CallOption) (*GetCommentsRsp, error)
+	GetCommentThread(ctx context.Context, in *GetCommentThreadReq, opts ...grpc.CallOption) (*GetCommentThreadRsp, error)
 	CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*CreateCommentRsp, error)
 	EditComment(ctx context.Context, in *EditCommentReq, opts ...grpc.CallOption) (*EditCommentRsp, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRsp, error)
 	LikeComment(ctx context.Context, in *LikeCommentReq, opts ...grpc.CallOption) (*LikeCommentRsp, error)
 	DislikeComment(ctx context.Context, in *DislikeCommentReq, opts ...grpc.CallOption) (*DislikeCommentRsp, error)
+	React(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*ReactRsp, error)
+	Unreact(ctx context.Context, in *UnreactReq, opts ...grpc.CallOption) (*UnreactRsp, error)
+	Vote(ctx context.Context, in *VoteReq, opts ...grpc.CallOption) (*VoteRsp, error)
+	ListCommentLikers(ctx context.Context, in *ListCommentLikersReq, opts ...grpc.CallOption) (*ListCommentLikersRsp, error)
+	RestoreComment(ctx context.Context, in *RestoreCommentReq, opts ...grpc.CallOption) (*RestoreCommentRsp, error)
+	GetCommentHistory(ctx context.Context, in *GetCommentHistoryReq, opts ...grpc.CallOption) (*GetCommentHistoryRsp, error)
+	SearchPosts(ctx context.Context, in *SearchPostsReq, opts ...grpc.CallOption) (*SearchPostsRsp, error)
+	SearchComments(ctx context.Context, in *SearchCommentsReq, opts ...grpc.CallOption) (*SearchCommentsRsp, error)
+	GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserRsp, error)
+	UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileRsp, error)
+	ListUserPosts(ctx context.Context, in *ListUserPostsReq, opts ...grpc.CallOption) (*ListUserPostsRsp, error)
+	ListUserComments(ctx context.Context, in *ListUserCommentsReq, opts ...grpc.CallOption) (*ListUserCommentsRsp, error)
+	Follow(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*FollowRsp, error)
+	Unfollow(ctx context.Context, in *UnfollowReq, opts ...grpc.CallOption) (*UnfollowRsp, error)
+	ListFollowers(ctx context.Context, in *ListFollowersReq, opts ...grpc.CallOption) (*ListFollowersRsp, error)
+	ListFollowing(ctx context.Context, in *ListFollowingReq, opts ...grpc.CallOption) (*ListFollowingRsp, error)
+	GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*GetFeedRsp, error)
+	Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterRsp, error)
+	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRsp, error)
+	Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutRsp, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenRsp, error)
 }
 
 type serviceClient struct {
@@ -119,6 +169,36 @@ func (c *serviceClient) DislikePost(ctx context.Context, in *DislikePostReq, opt
 	return out, nil
 }
 
+func (c *serviceClient) ListPostLikers(ctx context.Context, in *ListPostLikersReq, opts ...grpc.CallOption) (*ListPostLikersRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostLikersRsp)
+	err := c.cc.Invoke(ctx, Service_ListPostLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RestorePost(ctx context.Context, in *RestorePostReq, opts ...grpc.CallOption) (*RestorePostRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostRsp)
+	err := c.cc.Invoke(ctx, Service_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetPostHistory(ctx context.Context, in *GetPostHistoryReq, opts ...grpc.CallOption) (*GetPostHistoryRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostHistoryRsp)
+	err := c.cc.Invoke(ctx, Service_GetPostHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetComments(ctx context.Context, in *GetCommentsReq, opts ...grpc.CallOption) (*GetCommentsRsp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCommentsRsp)
@@ -129,6 +209,16 @@ func (c *serviceClient) GetComments(ctx context.Context, in *GetCommentsReq, opt
 	return out, nil
 }
 
+func (c *serviceClient) GetCommentThread(ctx context.Context, in *GetCommentThreadReq, opts ...grpc.CallOption) (*GetCommentThreadRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentThreadRsp)
+	err := c.cc.Invoke(ctx, Service_GetCommentThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) CreateComment(ctx context.Context, in *CreateCommentReq, opts ...grpc.CallOption) (*CreateCommentRsp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCommentRsp)
@@ -179,6 +269,216 @@ func (c *serviceClient) DislikeComment(ctx context.Context, in *DislikeCommentRe
 	return out, nil
 }
 
+func (c *serviceClient) React(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*ReactRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactRsp)
+	err := c.cc.Invoke(ctx, Service_React_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Unreact(ctx context.Context, in *UnreactReq, opts ...grpc.CallOption) (*UnreactRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnreactRsp)
+	err := c.cc.Invoke(ctx, Service_Unreact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Vote(ctx context.Context, in *VoteReq, opts ...grpc.CallOption) (*VoteRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteRsp)
+	err := c.cc.Invoke(ctx, Service_Vote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListCommentLikers(ctx context.Context, in *ListCommentLikersReq, opts ...grpc.CallOption) (*ListCommentLikersRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentLikersRsp)
+	err := c.cc.Invoke(ctx, Service_ListCommentLikers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RestoreComment(ctx context.Context, in *RestoreCommentReq, opts ...grpc.CallOption) (*RestoreCommentRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCommentRsp)
+	err := c.cc.Invoke(ctx, Service_RestoreComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetCommentHistory(ctx context.Context, in *GetCommentHistoryReq, opts ...grpc.CallOption) (*GetCommentHistoryRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommentHistoryRsp)
+	err := c.cc.Invoke(ctx, Service_GetCommentHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SearchPosts(ctx context.Context, in *SearchPostsReq, opts ...grpc.CallOption) (*SearchPostsRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostsRsp)
+	err := c.cc.Invoke(ctx, Service_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) SearchComments(ctx context.Context, in *SearchCommentsReq, opts ...grpc.CallOption) (*SearchCommentsRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCommentsRsp)
+	err := c.cc.Invoke(ctx, Service_SearchComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetUser(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*GetUserRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserRsp)
+	err := c.cc.Invoke(ctx, Service_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UpdateProfile(ctx context.Context, in *UpdateProfileReq, opts ...grpc.CallOption) (*UpdateProfileRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileRsp)
+	err := c.cc.Invoke(ctx, Service_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListUserPosts(ctx context.Context, in *ListUserPostsReq, opts ...grpc.CallOption) (*ListUserPostsRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserPostsRsp)
+	err := c.cc.Invoke(ctx, Service_ListUserPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListUserComments(ctx context.Context, in *ListUserCommentsReq, opts ...grpc.CallOption) (*ListUserCommentsRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserCommentsRsp)
+	err := c.cc.Invoke(ctx, Service_ListUserComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Follow(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*FollowRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowRsp)
+	err := c.cc.Invoke(ctx, Service_Follow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Unfollow(ctx context.Context, in *UnfollowReq, opts ...grpc.CallOption) (*UnfollowRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowRsp)
+	err := c.cc.Invoke(ctx, Service_Unfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListFollowers(ctx context.Context, in *ListFollowersReq, opts ...grpc.CallOption) (*ListFollowersRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowersRsp)
+	err := c.cc.Invoke(ctx, Service_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ListFollowing(ctx context.Context, in *ListFollowingReq, opts ...grpc.CallOption) (*ListFollowingRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowingRsp)
+	err := c.cc.Invoke(ctx, Service_ListFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*GetFeedRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedRsp)
+	err := c.cc.Invoke(ctx, Service_GetFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Register(ctx context.Context, in *RegisterReq, opts ...grpc.CallOption) (*RegisterRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterRsp)
+	err := c.cc.Invoke(ctx, Service_Register_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginRsp)
+	err := c.cc.Invoke(ctx, Service_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) Logout(ctx context.Context, in *LogoutReq, opts ...grpc.CallOption) (*LogoutRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutRsp)
+	err := c.cc.Invoke(ctx, Service_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenRsp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenRsp)
+	err := c.cc.Invoke(ctx, Service_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility.
@@ -189,12 +489,37 @@ type ServiceServer interface {
 	DeletePost(context.Context, *DeletePostReq) (*DeletePostRsp, error)
 	LikePost(context.Context, *LikePostReq) (*LikePostRsp, error)
 	DislikePost(context.Context, *DislikePostReq) (*DislikePostRsp, error)
+	ListPostLikers(context.Context, *ListPostLikersReq) (*ListPostLikersRsp, error)
+	RestorePost(context.Context, *RestorePostReq) (*RestorePostRsp, error)
+	GetPostHistory(context.Context, *GetPostHistoryReq) (*GetPostHistoryRsp, error)
 	GetComments(context.Context, *GetCommentsReq) (*GetCommentsRsp, error)
+	GetCommentThread(context.Context, *GetCommentThreadReq) (*GetCommentThreadRsp, error)
 	CreateComment(context.Context, *CreateCommentReq) (*CreateCommentRsp, error)
 	EditComment(context.Context, *EditCommentReq) (*EditCommentRsp, error)
 	DeleteComment(context.Context, *DeleteCommentReq) (*DeleteCommentRsp, error)
 	LikeComment(context.Context, *LikeCommentReq) (*LikeCommentRsp, error)
 	DislikeComment(context.Context, *DislikeCommentReq) (*DislikeCommentRsp, error)
+	React(context.Context, *ReactReq) (*ReactRsp, error)
+	Unreact(context.Context, *UnreactReq) (*UnreactRsp, error)
+	Vote(context.Context, *VoteReq) (*VoteRsp, error)
+	ListCommentLikers(context.Context, *ListCommentLikersReq) (*ListCommentLikersRsp, error)
+	RestoreComment(context.Context, *RestoreCommentReq) (*RestoreCommentRsp, error)
+	GetCommentHistory(context.Context, *GetCommentHistoryReq) (*GetCommentHistoryRsp, error)
+	SearchPosts(context.Context, *SearchPostsReq) (*SearchPostsRsp, error)
+	SearchComments(context.Context, *SearchCommentsReq) (*SearchCommentsRsp, error)
+	GetUser(context.Context, *GetUserReq) (*GetUserRsp, error)
+	UpdateProfile(context.Context, *UpdateProfileReq) (*UpdateProfileRsp, error)
+	ListUserPosts(context.Context, *ListUserPostsReq) (*ListUserPostsRsp, error)
+	ListUserComments(context.Context, *ListUserCommentsReq) (*ListUserCommentsRsp, error)
+	Follow(context.Context, *FollowReq) (*FollowRsp, error)
+	Unfollow(context.Context, *UnfollowReq) (*UnfollowRsp, error)
+	ListFollowers(context.Context, *ListFollowersReq) (*ListFollowersRsp, error)
+	ListFollowing(context.Context, *ListFollowingReq) (*ListFollowingRsp, error)
+	GetFeed(context.Context, *GetFeedReq) (*GetFeedRsp, error)
+	Register(context.Context, *RegisterReq) (*RegisterRsp, error)
+	Login(context.Context, *LoginReq) (*LoginRsp, error)
+	Logout(context.Context, *LogoutReq) (*LogoutRsp, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenRsp, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -223,9 +548,21 @@ func (UnimplementedServiceServer) LikePost(context.Context, *LikePostReq) (*Like
 func (UnimplementedServiceServer) DislikePost(context.Context, *DislikePostReq) (*DislikePostRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DislikePost not implemented")
 }
+func (UnimplementedServiceServer) ListPostLikers(context.Context, *ListPostLikersReq) (*ListPostLikersRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostLikers not implemented")
+}
+func (UnimplementedServiceServer) RestorePost(context.Context, *RestorePostReq) (*RestorePostRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedServiceServer) GetPostHistory(context.Context, *GetPostHistoryReq) (*GetPostHistoryRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostHistory not implemented")
+}
 func (UnimplementedServiceServer) GetComments(context.Context, *GetCommentsReq) (*GetCommentsRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
 }
+func (UnimplementedServiceServer) GetCommentThread(context.Context, *GetCommentThreadReq) (*GetCommentThreadRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentThread not implemented")
+}
 func (UnimplementedServiceServer) CreateComment(context.Context, *CreateCommentReq) (*CreateCommentRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
@@ -241,6 +578,69 @@ func (UnimplementedServiceServer) LikeComment(context.Context, *LikeCommentReq)
 func (UnimplementedServiceServer) DislikeComment(context.Context, *DislikeCommentReq) (*DislikeCommentRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DislikeComment not implemented")
 }
+func (UnimplementedServiceServer) React(context.Context, *ReactReq) (*ReactRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (UnimplementedServiceServer) Unreact(context.Context, *UnreactReq) (*UnreactRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unreact not implemented")
+}
+func (UnimplementedServiceServer) Vote(context.Context, *VoteReq) (*VoteRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (UnimplementedServiceServer) ListCommentLikers(context.Context, *ListCommentLikersReq) (*ListCommentLikersRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentLikers not implemented")
+}
+func (UnimplementedServiceServer) RestoreComment(context.Context, *RestoreCommentReq) (*RestoreCommentRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreComment not implemented")
+}
+func (UnimplementedServiceServer) GetCommentHistory(context.Context, *GetCommentHistoryReq) (*GetCommentHistoryRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentHistory not implemented")
+}
+func (UnimplementedServiceServer) SearchPosts(context.Context, *SearchPostsReq) (*SearchPostsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedServiceServer) SearchComments(context.Context, *SearchCommentsReq) (*SearchCommentsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchComments not implemented")
+}
+func (UnimplementedServiceServer) GetUser(context.Context, *GetUserReq) (*GetUserRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedServiceServer) UpdateProfile(context.Context, *UpdateProfileReq) (*UpdateProfileRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedServiceServer) ListUserPosts(context.Context, *ListUserPostsReq) (*ListUserPostsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserPosts not implemented")
+}
+func (UnimplementedServiceServer) ListUserComments(context.Context, *ListUserCommentsReq) (*ListUserCommentsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserComments not implemented")
+}
+func (UnimplementedServiceServer) Follow(context.Context, *FollowReq) (*FollowRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedServiceServer) Unfollow(context.Context, *UnfollowReq) (*UnfollowRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedServiceServer) ListFollowers(context.Context, *ListFollowersReq) (*ListFollowersRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedServiceServer) ListFollowing(context.Context, *ListFollowingReq) (*ListFollowingRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedServiceServer) GetFeed(context.Context, *GetFeedReq) (*GetFeedRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedServiceServer) Register(context.Context, *RegisterReq) (*RegisterRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedServiceServer) Login(context.Context, *LoginReq) (*LoginRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedServiceServer) Logout(context.Context, *LogoutReq) (*LogoutRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedServiceServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 func (UnimplementedServiceServer) testEmbeddedByValue()                 {}
 
@@ -370,6 +770,60 @@ func _Service_DislikePost_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_ListPostLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostLikersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListPostLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListPostLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListPostLikers(ctx, req.(*ListPostLikersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RestorePost(ctx, req.(*RestorePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetPostHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetPostHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetPostHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetPostHistory(ctx, req.(*GetPostHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCommentsReq)
 	if err := dec(in); err != nil {
@@ -388,6 +842,24 @@ func _Service_GetComments_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetCommentThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentThreadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetCommentThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetCommentThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetCommentThread(ctx, req.(*GetCommentThreadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCommentReq)
 	if err := dec(in); err != nil {
@@ -478,10 +950,388 @@ func _Service_DislikeComment_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Service_ServiceDesc = grpc.ServiceDesc{
+func _Service_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_React_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).React(ctx, req.(*ReactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Unreact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnreactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Unreact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Unreact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Unreact(ctx, req.(*UnreactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Vote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Vote(ctx, req.(*VoteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListCommentLikers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentLikersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListCommentLikers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListCommentLikers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListCommentLikers(ctx, req.(*ListCommentLikersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RestoreComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RestoreComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RestoreComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RestoreComment(ctx, req.(*RestoreCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetCommentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommentHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetCommentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetCommentHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetCommentHistory(ctx, req.(*GetCommentHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchPosts(ctx, req.(*SearchPostsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_SearchComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SearchComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_SearchComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SearchComments(ctx, req.(*SearchCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetUser(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).UpdateProfile(ctx, req.(*UpdateProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListUserPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserPostsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListUserPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListUserPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListUserPosts(ctx, req.(*ListUserPostsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListUserComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListUserComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListUserComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListUserComments(ctx, req.(*ListUserCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Follow(ctx, req.(*FollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Unfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Unfollow(ctx, req.(*UnfollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListFollowers(ctx, req.(*ListFollowersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ListFollowing(ctx, req.(*ListFollowingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetFeed(ctx, req.(*GetFeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Register(ctx, req.(*RegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).Logout(ctx, req.(*LogoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Service_ServiceDesc is the grpc.ServiceDesc for Service service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Service_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "go_1C.Service",
 	HandlerType: (*ServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -509,10 +1359,26 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DislikePost",
 			Handler:    _Service_DislikePost_Handler,
 		},
+		{
+			MethodName: "ListPostLikers",
+			Handler:    _Service_ListPostLikers_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _Service_RestorePost_Handler,
+		},
+		{
+			MethodName: "GetPostHistory",
+			Handler:    _Service_GetPostHistory_Handler,
+		},
 		{
 			MethodName: "GetComments",
 			Handler:    _Service_GetComments_Handler,
 		},
+		{
+			MethodName: "GetCommentThread",
+			Handler:    _Service_GetCommentThread_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _Service_CreateComment_Handler,
@@ -533,6 +1399,90 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DislikeComment",
 			Handler:    _Service_DislikeComment_Handler,
 		},
+		{
+			MethodName: "React",
+			Handler:    _Service_React_Handler,
+		},
+		{
+			MethodName: "Unreact",
+			Handler:    _Service_Unreact_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Service_Vote_Handler,
+		},
+		{
+			MethodName: "ListCommentLikers",
+			Handler:    _Service_ListCommentLikers_Handler,
+		},
+		{
+			MethodName: "RestoreComment",
+			Handler:    _Service_RestoreComment_Handler,
+		},
+		{
+			MethodName: "GetCommentHistory",
+			Handler:    _Service_GetCommentHistory_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Service_SearchPosts_Handler,
+		},
+		{
+			MethodName: "SearchComments",
+			Handler:    _Service_SearchComments_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _Service_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _Service_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "ListUserPosts",
+			Handler:    _Service_ListUserPosts_Handler,
+		},
+		{
+			MethodName: "ListUserComments",
+			Handler:    _Service_ListUserComments_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _Service_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _Service_Unfollow_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _Service_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _Service_ListFollowing_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _Service_GetFeed_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _Service_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Service_Login_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Service_Logout_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Service_RefreshToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/server.proto",
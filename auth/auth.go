@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+
+	"go_1C/errs"
+)
+
+type userKey struct{}
+
+// ContextWithUser stores authenticated user id in the context.
+func ContextWithUser(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userKey{}, userID)
+}
+
+// UserID returns authenticated user id or 0 for anonymous requests.
+func UserID(ctx context.Context) int64 {
+	userID, _ := ctx.Value(userKey{}).(int64)
+	return userID
+}
+
+// RequireUser returns authenticated user id or Unauthenticated error.
+func RequireUser(ctx context.Context) (int64, error) {
+	userID := UserID(ctx)
+	if userID == 0 {
+		return 0, errs.Unauthenticated("You are not logged in!")
+	}
+	return userID, nil
+}
+
+// AuthFunc validates bearer token from metadata. The gateway forwards the
+// HTTP Authorization header as "authorization" metadata, so both gRPC and
+// REST clients are handled here. Requests without a token are anonymous.
+func (m *TokenManager) AuthFunc(ctx context.Context) (context.Context, error) {
+	if metautils.ExtractIncoming(ctx).Get("authorization") == "" {
+		return ctx, nil
+	}
+
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := m.Verify(token)
+	if err != nil {
+		return nil, errs.Unauthenticated(err.Error())
+	}
+
+	return ContextWithUser(ctx, userID), nil
+}
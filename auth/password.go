@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2id parameters recommended by RFC 9106 for memory constrained environments
+const (
+	argonTime    = 3
+	argonMemory  = 64 * 1024
+	argonThreads = 2
+	argonKeyLen  = 32
+	saltLen      = 16
+)
+
+// HashPassword returns argon2id hash of the password with a fresh random salt.
+func HashPassword(password string) (hash []byte, salt []byte, err error) {
+	salt = make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, nil, err
+	}
+
+	return argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen), salt, nil
+}
+
+func CheckPassword(password string, hash []byte, salt []byte) bool {
+	actual := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	return subtle.ConstantTimeCompare(actual, hash) == 1
+}
+
+// NewRefreshToken returns random opaque token. It means nothing by itself
+// and is valid only while it is stored on the server side.
+func NewRefreshToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+
+	api "go_1C/api"
+)
+
+// Main page posts are cached under a versioned key. Every mutation bumps the
+// version, so a page loaded before the mutation can't be stored under the
+// current key and stale data is never served after invalidation.
+const versionKey = "cached_posts:version"
+
+// Shared load is not cancelled with the caller which started it, so it is
+// limited by its own timeout.
+const loadTimeout = 30 * time.Second
+
+func postsKey(version int64) string {
+	return "cached_posts:v" + strconv.FormatInt(version, 10)
+}
+
+// PostsCache caches posts of the main page. Per viewer fields (is_liked) are
+// not cached and must be filled by the caller.
+type PostsCache struct {
+	store Store
+	ttl   time.Duration
+	group singleflight.Group
+}
+
+func NewPostsCache(store Store, ttl time.Duration) *PostsCache {
+	return &PostsCache{store: store, ttl: ttl}
+}
+
+// Get returns cached posts or loads them. Concurrent misses share one load,
+// it gets context detached from the caller, so waiters do not fail when the
+// first caller goes away. Every call gets its own copy of posts.
+func (c *PostsCache) Get(ctx context.Context, load func(ctx context.Context) ([]*api.Post, error)) ([]*api.Post, error) {
+	var version int64
+	value, err := c.store.Get(ctx, versionKey)
+	if err == nil {
+		version, err = strconv.ParseInt(string(value), 10, 64)
+	}
+	if err != nil && err != ErrMiss {
+		return nil, err
+	}
+
+	data, err := c.store.Get(ctx, postsKey(version))
+	if err == ErrMiss {
+		value, err, _ := c.group.Do(postsKey(version), func() (interface{}, error) {
+			load_ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+			defer cancel()
+
+			posts, err := load(load_ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			data, err := proto.Marshal(&api.GetPostsRsp{Posts: posts})
+			if err != nil {
+				return nil, err
+			}
+
+			if err := c.store.Set(load_ctx, postsKey(version), data, c.ttl); err != nil {
+				return nil, err
+			}
+			return data, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		data = value.([]byte)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var cached api.GetPostsRsp
+	if err := proto.Unmarshal(data, &cached); err != nil {
+		return nil, err
+	}
+	return cached.Posts, nil
+}
+
+// Invalidate drops cached posts, it must be called after every change of
+// posts, comments, likes or authors shown on the main page.
+func (c *PostsCache) Invalidate(ctx context.Context) error {
+	return c.store.Incr(ctx, versionKey)
+}
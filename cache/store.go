@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var ErrMiss = errors.New("cache miss")
+
+// Store keeps cached values. Values written by Incr are decimal numbers.
+type Store interface {
+	// Get returns ErrMiss if there is no value.
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Incr(ctx context.Context, key string) error
+}
+
+type RedisStore struct {
+	rdb *redis.Client
+}
+
+func NewRedisStore(rdb *redis.Client) *RedisStore {
+	return &RedisStore{rdb: rdb}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := s.rdb.Get(ctx, key).Bytes()
+	if err == redis.Nil {
+		return nil, ErrMiss
+	}
+	return value, err
+}
+
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.rdb.Set(ctx, key, value, ttl).Err()
+}
+
+func (s *RedisStore) Incr(ctx context.Context, key string) error {
+	return s.rdb.Incr(ctx, key).Err()
+}
+
+type memoryValue struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// MemoryStore keeps values in process memory, expired values are dropped on write.
+type MemoryStore struct {
+	mu     sync.Mutex
+	values map[string]memoryValue
+	now    func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{values: map[string]memoryValue{}, now: time.Now}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.values[key]
+	if !ok || s.expired(value) {
+		return nil, ErrMiss
+	}
+	return value.value, nil
+}
+
+func (s *MemoryStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dropExpired()
+	s.values[key] = memoryValue{value: value, expiresAt: s.now().Add(ttl)}
+	return nil
+}
+
+func (s *MemoryStore) Incr(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var number int64
+	if value, ok := s.values[key]; ok && !s.expired(value) {
+		var err error
+		if number, err = strconv.ParseInt(string(value.value), 10, 64); err != nil {
+			return err
+		}
+	}
+	s.values[key] = memoryValue{value: []byte(strconv.FormatInt(number+1, 10))}
+	return nil
+}
+
+// zero expiresAt means the value never expires
+func (s *MemoryStore) expired(value memoryValue) bool {
+	return !value.expiresAt.IsZero() && !s.now().Before(value.expiresAt)
+}
+
+func (s *MemoryStore) dropExpired() {
+	for key, value := range s.values {
+		if s.expired(value) {
+			delete(s.values, key)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	api "go_1C/api"
+)
+
+func TestRegisterAndLogin(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+
+	alice := ts.register(t, "alice")
+	if alice.id == 0 || alice.tokens.AccessToken == "" || alice.tokens.RefreshToken == "" || alice.tokens.ExpiresIn <= 0 {
+		t.Fatalf("Unexpected tokens: %v", alice.tokens)
+	}
+
+	_, err := ts.client.Register(ctx, &api.RegisterReq{Login: "alice", Password: "password-other", Name: "Other"})
+	requireCode(t, err, codes.AlreadyExists)
+
+	_, err = ts.client.Register(ctx, &api.RegisterReq{Login: "bob", Password: "short", Name: "Bob"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Register(ctx, &api.RegisterReq{Login: "", Password: "password-bob", Name: "Bob"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Register(ctx, &api.RegisterReq{Login: strings.Repeat("b", 51), Password: "password-bob", Name: "Bob"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Register(ctx, &api.RegisterReq{Login: "bob", Password: "password-bob", Name: strings.Repeat("б", 51)})
+	requireCode(t, err, codes.InvalidArgument)
+
+	// limits are in characters, not bytes
+	_, err = ts.client.Register(ctx, &api.RegisterReq{Login: "bob", Password: "password-bob", Name: strings.Repeat("б", 50)})
+	requireOK(t, err)
+
+	login, err := ts.client.Login(ctx, &api.LoginReq{Login: "alice", Password: "password-alice"})
+	requireOK(t, err)
+	if login.User.Id != alice.id || login.User.Name != "Name alice" {
+		t.Fatalf("Unexpected user: %v", login.User)
+	}
+
+	_, err = ts.client.Login(ctx, &api.LoginReq{Login: "alice", Password: "wrong-password"})
+	requireCode(t, err, codes.Unauthenticated)
+
+	_, err = ts.client.Login(ctx, &api.LoginReq{Login: "nobody", Password: "password-alice"})
+	requireCode(t, err, codes.Unauthenticated)
+
+	// token of the login works as well as the one of registration
+	_, err = ts.client.CreatePost(withToken(login.Tokens.AccessToken), &api.CreatePostReq{Post: &api.PostBody{Title: "T", Body: "B"}})
+	requireOK(t, err)
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	ts := newTestServer(t)
+	ctx := context.Background()
+
+	alice := ts.register(t, "alice")
+
+	refreshed, err := ts.client.RefreshToken(ctx, &api.RefreshTokenReq{RefreshToken: alice.tokens.RefreshToken})
+	requireOK(t, err)
+	if refreshed.Tokens.RefreshToken == alice.tokens.RefreshToken {
+		t.Fatal("Refresh token is not rotated")
+	}
+
+	// refresh token is single use
+	_, err = ts.client.RefreshToken(ctx, &api.RefreshTokenReq{RefreshToken: alice.tokens.RefreshToken})
+	requireCode(t, err, codes.Unauthenticated)
+
+	_, err = ts.client.RefreshToken(ctx, &api.RefreshTokenReq{RefreshToken: "garbage"})
+	requireCode(t, err, codes.Unauthenticated)
+
+	// session of another user can not be revoked
+	bob := ts.register(t, "bob")
+	_, err = ts.client.Logout(bob.ctx, &api.LogoutReq{RefreshToken: refreshed.Tokens.RefreshToken})
+	requireCode(t, err, codes.PermissionDenied)
+
+	alice_ctx := withToken(refreshed.Tokens.AccessToken)
+	_, err = ts.client.Logout(alice_ctx, &api.LogoutReq{RefreshToken: refreshed.Tokens.RefreshToken})
+	requireOK(t, err)
+
+	_, err = ts.client.RefreshToken(ctx, &api.RefreshTokenReq{RefreshToken: refreshed.Tokens.RefreshToken})
+	requireCode(t, err, codes.Unauthenticated)
+
+	// logout of unknown token is not an error
+	_, err = ts.client.Logout(alice_ctx, &api.LogoutReq{RefreshToken: "garbage"})
+	requireOK(t, err)
+}
+
+func TestAuthorizationFailures(t *testing.T) {
+	ts := newTestServer(t)
+	anonymous := context.Background()
+
+	_, err := ts.client.CreatePost(anonymous, &api.CreatePostReq{Post: &api.PostBody{Title: "T", Body: "B"}})
+	requireCode(t, err, codes.Unauthenticated)
+
+	_, err = ts.client.CreatePost(withToken("not.a.token"), &api.CreatePostReq{Post: &api.PostBody{Title: "T", Body: "B"}})
+	requireCode(t, err, codes.Unauthenticated)
+
+	// invalid token fails even methods open to anonymous users
+	_, err = ts.client.GetPosts(withToken("not.a.token"), &api.GetPostsReq{Limit: 10})
+	requireCode(t, err, codes.Unauthenticated)
+
+	_, err = ts.client.GetPosts(anonymous, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+
+	calls := map[string]func() error{
+		"EditPost": func() error {
+			_, err := ts.client.EditPost(anonymous, &api.EditPostReq{PostId: 1, Post: &api.PostBody{Title: "T", Body: "B"}})
+			return err
+		},
+		"DeletePost": func() error {
+			_, err := ts.client.DeletePost(anonymous, &api.DeletePostReq{PostId: 1})
+			return err
+		},
+		"RestorePost": func() error {
+			_, err := ts.client.RestorePost(anonymous, &api.RestorePostReq{PostId: 1})
+			return err
+		},
+		"LikePost": func() error {
+			_, err := ts.client.LikePost(anonymous, &api.LikePostReq{PostId: 1})
+			return err
+		},
+		"DislikePost": func() error {
+			_, err := ts.client.DislikePost(anonymous, &api.DislikePostReq{PostId: 1})
+			return err
+		},
+		"CreateComment": func() error {
+			_, err := ts.client.CreateComment(anonymous, &api.CreateCommentReq{PostId: 1, Body: "B"})
+			return err
+		},
+		"EditComment": func() error {
+			_, err := ts.client.EditComment(anonymous, &api.EditCommentReq{CommentId: 1, Body: "B"})
+			return err
+		},
+		"DeleteComment": func() error {
+			_, err := ts.client.DeleteComment(anonymous, &api.DeleteCommentReq{CommentId: 1})
+			return err
+		},
+		"RestoreComment": func() error {
+			_, err := ts.client.RestoreComment(anonymous, &api.RestoreCommentReq{CommentId: 1})
+			return err
+		},
+		"LikeComment": func() error {
+			_, err := ts.client.LikeComment(anonymous, &api.LikeCommentReq{CommentId: 1})
+			return err
+		},
+		"DislikeComment": func() error {
+			_, err := ts.client.DislikeComment(anonymous, &api.DislikeCommentReq{CommentId: 1})
+			return err
+		},
+		"UpdateProfile": func() error {
+			_, err := ts.client.UpdateProfile(anonymous, &api.UpdateProfileReq{})
+			return err
+		},
+		"Follow": func() error {
+			_, err := ts.client.Follow(anonymous, &api.FollowReq{FolloweeId: 1})
+			return err
+		},
+		"Unfollow": func() error {
+			_, err := ts.client.Unfollow(anonymous, &api.UnfollowReq{FolloweeId: 1})
+			return err
+		},
+		"GetFeed": func() error {
+			_, err := ts.client.GetFeed(anonymous, &api.GetFeedReq{Limit: 10})
+			return err
+		},
+		"Logout": func() error {
+			_, err := ts.client.Logout(anonymous, &api.LogoutReq{RefreshToken: "token"})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			requireCode(t, call(), codes.Unauthenticated)
+		})
+	}
+}
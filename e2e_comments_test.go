@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	api "go_1C/api"
+)
+
+func TestCommentLifecycle(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, bob, post.Id, 0, "First")
+	if comment.Author.Id != bob.id || comment.PostId != post.Id || comment.ParentCommentId != 0 {
+		t.Fatalf("Unexpected comment: %v", comment)
+	}
+
+	posts, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if posts.Posts[0].Comments != 1 {
+		t.Fatalf("Comment is not counted: %v", posts.Posts[0])
+	}
+
+	_, err = ts.client.EditComment(alice.ctx, &api.EditCommentReq{CommentId: comment.Id, Body: "Alice"})
+	requireCode(t, err, codes.PermissionDenied)
+
+	edited, err := ts.client.EditComment(bob.ctx, &api.EditCommentReq{CommentId: comment.Id, Body: "First, edited"})
+	requireOK(t, err)
+	if edited.Comment.Body != "First, edited" || edited.Comment.EditedAt == nil {
+		t.Fatalf("Unexpected edited comment: %v", edited.Comment)
+	}
+
+	history, err := ts.client.GetCommentHistory(context.Background(), &api.GetCommentHistoryReq{CommentId: comment.Id, Limit: 10})
+	requireOK(t, err)
+	if len(history.Revisions) != 1 || history.Revisions[0].Body != "First" {
+		t.Fatalf("Unexpected history: %v", history.Revisions)
+	}
+
+	_, err = ts.client.GetCommentHistory(context.Background(), &api.GetCommentHistoryReq{CommentId: comment.Id, Limit: 0})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.DeleteComment(alice.ctx, &api.DeleteCommentReq{CommentId: comment.Id})
+	requireCode(t, err, codes.PermissionDenied)
+
+	_, err = ts.client.DeleteComment(bob.ctx, &api.DeleteCommentReq{CommentId: comment.Id})
+	requireOK(t, err)
+
+	comments, err := ts.client.GetComments(context.Background(), &api.GetCommentsReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	if len(comments.Comments) != 0 {
+		t.Fatalf("Deleted comment is listed: %v", comments.Comments)
+	}
+
+	_, err = ts.client.GetCommentHistory(alice.ctx, &api.GetCommentHistoryReq{CommentId: comment.Id, Limit: 10})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.RestoreComment(alice.ctx, &api.RestoreCommentReq{CommentId: comment.Id})
+	requireCode(t, err, codes.PermissionDenied)
+
+	restored, err := ts.client.RestoreComment(bob.ctx, &api.RestoreCommentReq{CommentId: comment.Id})
+	requireOK(t, err)
+	if restored.Comment.Body != "First, edited" || restored.Comment.Deleted {
+		t.Fatalf("Unexpected restored comment: %v", restored.Comment)
+	}
+
+	_, err = ts.client.RestoreComment(bob.ctx, &api.RestoreCommentReq{CommentId: comment.Id})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestPostCommentsCount(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	p1 := ts.createPost(t, alice, "First")
+	p2 := ts.createPost(t, alice, "Second")
+	c1 := ts.createComment(t, bob, p1.Id, 0, "Comment")
+	reply := ts.createComment(t, alice, p1.Id, c1.Id, "Reply")
+	ts.createComment(t, bob, p2.Id, 0, "Comment")
+
+	requireCounts := func(first int64, second int64) {
+		t.Helper()
+		posts, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10})
+		requireOK(t, err)
+		counts := map[int64]int64{}
+		for _, post := range posts.Posts {
+			counts[post.Id] = post.Comments
+		}
+		if counts[p1.Id] != first || counts[p2.Id] != second {
+			t.Fatalf("Expected %d and %d comments, got %v", first, second, counts)
+		}
+	}
+	requireCounts(2, 1)
+
+	_, err := ts.client.DeleteComment(alice.ctx, &api.DeleteCommentReq{CommentId: reply.Id})
+	requireOK(t, err)
+	_, err = ts.client.DeleteComment(bob.ctx, &api.DeleteCommentReq{CommentId: c1.Id})
+	requireOK(t, err)
+	_, err = ts.client.DeleteComment(bob.ctx, &api.DeleteCommentReq{CommentId: c1.Id})
+	requireCode(t, err, codes.NotFound)
+	requireCounts(0, 1)
+
+	posts, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10, Sort: api.PostSort_POST_SORT_MOST_COMMENTED})
+	requireOK(t, err)
+	if !slices.Equal(postIDs(posts.Posts), []int64{p2.Id, p1.Id}) {
+		t.Fatalf("Unexpected most commented posts: %v", postIDs(posts.Posts))
+	}
+
+	_, err = ts.client.RestoreComment(bob.ctx, &api.RestoreCommentReq{CommentId: c1.Id})
+	requireOK(t, err)
+	requireCounts(1, 1)
+
+	// comments of deleted post are still counted when it is restored
+	_, err = ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: p1.Id})
+	requireOK(t, err)
+	_, err = ts.client.RestorePost(alice.ctx, &api.RestorePostReq{PostId: p1.Id})
+	requireOK(t, err)
+	requireCounts(1, 1)
+}
+
+func TestCommentLikes(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	_, err := ts.client.LikeComment(bob.ctx, &api.LikeCommentReq{CommentId: comment.Id})
+	requireOK(t, err)
+
+	_, err = ts.client.LikeComment(bob.ctx, &api.LikeCommentReq{CommentId: comment.Id})
+	requireCode(t, err, codes.AlreadyExists)
+
+	comments, err := ts.client.GetComments(bob.ctx, &api.GetCommentsReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	if comments.Comments[0].Likes != 1 || !comments.Comments[0].IsLiked {
+		t.Fatalf("Unexpected likes: %v", comments.Comments[0])
+	}
+
+	_, err = ts.client.DislikeComment(bob.ctx, &api.DislikeCommentReq{CommentId: comment.Id})
+	requireOK(t, err)
+
+	_, err = ts.client.DislikeComment(bob.ctx, &api.DislikeCommentReq{CommentId: comment.Id})
+	requireCode(t, err, codes.AlreadyExists)
+
+	comments, err = ts.client.GetComments(bob.ctx, &api.GetCommentsReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	if comments.Comments[0].Likes != 0 || comments.Comments[0].IsLiked {
+		t.Fatalf("Unexpected likes after dislike: %v", comments.Comments[0])
+	}
+}
+
+func TestGetCommentsPagination(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+
+	post := ts.createPost(t, alice, "Post")
+	other := ts.createPost(t, alice, "Other")
+	ts.createComment(t, alice, other.Id, 0, "Elsewhere")
+
+	var ids []int64
+	for _, body := range []string{"C1", "C2", "C3", "C4"} {
+		ids = append(ids, ts.createComment(t, alice, post.Id, 0, body).Id)
+	}
+
+	var got []int64
+	req := &api.GetCommentsReq{PostId: post.Id, Limit: 3}
+	for {
+		rsp, err := ts.client.GetComments(context.Background(), req)
+		requireOK(t, err)
+		got = append(got, commentIDs(rsp.Comments)...)
+		if rsp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = rsp.NextPageToken
+	}
+	if !slices.Equal(got, ids) {
+		t.Fatalf("Expected %v, got %v", ids, got)
+	}
+
+	rsp, err := ts.client.GetComments(context.Background(), &api.GetCommentsReq{PostId: post.Id, Offset: 2, Limit: 10})
+	requireOK(t, err)
+	if !slices.Equal(commentIDs(rsp.Comments), ids[2:]) {
+		t.Fatalf("Expected %v, got %v", ids[2:], commentIDs(rsp.Comments))
+	}
+
+	_, err = ts.client.GetComments(context.Background(), &api.GetCommentsReq{PostId: post.Id, Limit: 3, PageToken: "garbage"})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestCommentThread(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	post := ts.createPost(t, alice, "Post")
+	other := ts.createPost(t, alice, "Other")
+
+	root := ts.createComment(t, alice, post.Id, 0, "Root")
+	reply := ts.createComment(t, bob, post.Id, root.Id, "Reply")
+	ts.createComment(t, alice, post.Id, reply.Id, "Reply to reply")
+	ts.createComment(t, bob, post.Id, root.Id, "Second reply")
+	second_root := ts.createComment(t, bob, post.Id, 0, "Second root")
+
+	_, err := ts.client.CreateComment(bob.ctx, &api.CreateCommentReq{PostId: other.Id, ParentCommentId: root.Id, Body: "Wrong post"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.CreateComment(bob.ctx, &api.CreateCommentReq{PostId: post.Id, ParentCommentId: 1000, Body: "No parent"})
+	requireCode(t, err, codes.NotFound)
+
+	thread, err := ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Limit: 1, Depth: 2})
+	requireOK(t, err)
+	if !thread.HasMore || len(thread.Comments) != 1 {
+		t.Fatalf("Unexpected first level: %v", thread)
+	}
+	node := thread.Comments[0]
+	if node.Comment.Id != root.Id || node.Comment.ReplyCount != 2 || !node.HasMoreReplies || len(node.Replies) != 1 {
+		t.Fatalf("Unexpected root: %v", node)
+	}
+	if node.Replies[0].Comment.Id != reply.Id || !node.Replies[0].HasMoreReplies || len(node.Replies[0].Replies) != 0 {
+		t.Fatalf("Unexpected reply: %v", node.Replies[0])
+	}
+
+	thread, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Offset: 1, Limit: 10})
+	requireOK(t, err)
+	if thread.HasMore || len(thread.Comments) != 1 || thread.Comments[0].Comment.Id != second_root.Id {
+		t.Fatalf("Unexpected second page: %v", thread)
+	}
+
+	// deleted comment with replies stays in the thread as a tombstone
+	_, err = ts.client.DeleteComment(alice.ctx, &api.DeleteCommentReq{CommentId: root.Id})
+	requireOK(t, err)
+
+	thread, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	tombstone := thread.Comments[0]
+	if tombstone.Comment.Id != root.Id || !tombstone.Comment.Deleted || tombstone.Comment.Body != "" || tombstone.Comment.Author != nil {
+		t.Fatalf("Unexpected tombstone: %v", tombstone.Comment)
+	}
+	if len(tombstone.Replies) != 2 || len(tombstone.Replies[0].Replies) != 1 {
+		t.Fatalf("Replies of the tombstone are lost: %v", tombstone)
+	}
+
+	thread, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, CommentId: reply.Id, Limit: 10})
+	requireOK(t, err)
+	if len(thread.Comments) != 1 || thread.Comments[0].Comment.Body != "Reply to reply" {
+		t.Fatalf("Unexpected subthread: %v", thread)
+	}
+
+	_, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: other.Id, CommentId: reply.Id, Limit: 10})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Limit: 10, Depth: 100})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Limit: 0})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{Limit: 10})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Limit: 10, Depth: -1})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestSearchComments(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	post := ts.createPost(t, alice, "Post")
+	other := ts.createPost(t, alice, "Other")
+
+	matched := ts.createComment(t, bob, post.Id, 0, "Gophers are great")
+	ts.createComment(t, alice, other.Id, 0, "Gophers dig holes")
+	ts.createComment(t, bob, post.Id, 0, "Unrelated")
+
+	rsp, err := ts.client.SearchComments(context.Background(), &api.SearchCommentsReq{Query: "gophers", Limit: 10})
+	requireOK(t, err)
+	if len(rsp.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %v", rsp.Results)
+	}
+
+	rsp, err = ts.client.SearchComments(context.Background(), &api.SearchCommentsReq{Query: "gophers", PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	if len(rsp.Results) != 1 || rsp.Results[0].Comment.Id != matched.Id || rsp.Results[0].Snippet == "" {
+		t.Fatalf("Unexpected results: %v", rsp.Results)
+	}
+
+	rsp, err = ts.client.SearchComments(context.Background(), &api.SearchCommentsReq{Query: "gophers", AuthorId: alice.id, Limit: 10})
+	requireOK(t, err)
+	if len(rsp.Results) != 1 || rsp.Results[0].Comment.PostId != other.Id {
+		t.Fatalf("Unexpected results: %v", rsp.Results)
+	}
+
+	_, err = ts.client.SearchComments(context.Background(), &api.SearchCommentsReq{Query: "", Limit: 10})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestCommentsOfDeletedPost(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, bob, post.Id, 0, "Gophers are great")
+
+	requireListed := func(listed bool) {
+		t.Helper()
+		expected := []int64{}
+		if listed {
+			expected = []int64{comment.Id}
+		}
+
+		comments, err := ts.client.GetComments(context.Background(), &api.GetCommentsReq{PostId: post.Id, Limit: 10})
+		requireOK(t, err)
+		if !slices.Equal(commentIDs(comments.Comments), expected) {
+			t.Fatalf("Expected comments %v, got %v", expected, commentIDs(comments.Comments))
+		}
+
+		thread, err := ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Limit: 10})
+		requireOK(t, err)
+		if len(thread.Comments) != len(expected) {
+			t.Fatalf("Expected %d comments in thread, got %v", len(expected), thread.Comments)
+		}
+
+		user_comments, err := ts.client.ListUserComments(context.Background(), &api.ListUserCommentsReq{AuthorId: bob.id, Limit: 10})
+		requireOK(t, err)
+		if !slices.Equal(commentIDs(user_comments.Comments), expected) {
+			t.Fatalf("Expected user comments %v, got %v", expected, commentIDs(user_comments.Comments))
+		}
+
+		found, err := ts.client.SearchComments(context.Background(), &api.SearchCommentsReq{Query: "gophers", Limit: 10})
+		requireOK(t, err)
+		if len(found.Results) != len(expected) {
+			t.Fatalf("Expected %d search results, got %v", len(expected), found.Results)
+		}
+	}
+	requireListed(true)
+
+	_, err := ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: post.Id})
+	requireOK(t, err)
+	requireListed(false)
+
+	_, err = ts.client.RestorePost(alice.ctx, &api.RestorePostReq{PostId: post.Id})
+	requireOK(t, err)
+	requireListed(true)
+}
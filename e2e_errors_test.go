@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "go_1C/api"
+	"go_1C/storage"
+)
+
+// failingLikes fails every like with err.
+type failingLikes struct {
+	storage.LikeStore
+	err error
+}
+
+func (l failingLikes) LikePost(ctx context.Context, post_id uint, user_id int64) (bool, error) {
+	return false, l.err
+}
+
+func errorReason(t *testing.T, err error) string {
+	t.Helper()
+
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	t.Fatalf("No ErrorInfo in %v", err)
+	return ""
+}
+
+func TestErrorMapping(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	cases := map[string]struct {
+		call   func() error
+		code   codes.Code
+		reason string
+	}{
+		"EditMissingPost": {
+			call: func() error {
+				_, err := ts.client.EditPost(alice.ctx, &api.EditPostReq{PostId: 1000, Post: &api.PostBody{Title: "T", Body: "B"}})
+				return err
+			},
+			code:   codes.NotFound,
+			reason: "NOT_FOUND",
+		},
+		"DeleteMissingComment": {
+			call: func() error {
+				_, err := ts.client.DeleteComment(alice.ctx, &api.DeleteCommentReq{CommentId: 1000})
+				return err
+			},
+			code:   codes.NotFound,
+			reason: "NOT_FOUND",
+		},
+		"CommentMissingPost": {
+			call: func() error {
+				_, err := ts.client.CreateComment(alice.ctx, &api.CreateCommentReq{PostId: 1000, Body: "B"})
+				return err
+			},
+			code:   codes.NotFound,
+			reason: "NOT_FOUND",
+		},
+		"EditNotOwnPost": {
+			call: func() error {
+				_, err := ts.client.EditPost(bob.ctx, &api.EditPostReq{PostId: post.Id, Post: &api.PostBody{Title: "T", Body: "B"}})
+				return err
+			},
+			code:   codes.PermissionDenied,
+			reason: "PERMISSION_DENIED",
+		},
+		"EditNotOwnComment": {
+			call: func() error {
+				_, err := ts.client.EditComment(bob.ctx, &api.EditCommentReq{CommentId: comment.Id, Body: "B"})
+				return err
+			},
+			code:   codes.PermissionDenied,
+			reason: "PERMISSION_DENIED",
+		},
+		"FollowTwice": {
+			call: func() error {
+				if _, err := ts.client.Follow(bob.ctx, &api.FollowReq{FolloweeId: alice.id}); err != nil {
+					return err
+				}
+				_, err := ts.client.Follow(bob.ctx, &api.FollowReq{FolloweeId: alice.id})
+				return err
+			},
+			code:   codes.AlreadyExists,
+			reason: "CONFLICT",
+		},
+		"WrongPassword": {
+			call: func() error {
+				_, err := ts.client.Login(context.Background(), &api.LoginReq{Login: "alice", Password: "wrong-password"})
+				return err
+			},
+			code:   codes.Unauthenticated,
+			reason: "UNAUTHENTICATED",
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := c.call()
+			requireCode(t, err, c.code)
+			if reason := errorReason(t, err); reason != c.reason {
+				t.Fatalf("Expected reason %s, got %s", c.reason, reason)
+			}
+		})
+	}
+}
+
+func TestInternalErrorsAreHidden(t *testing.T) {
+	failure := errors.New("pq: password authentication failed for user secret")
+	ts := newTestServer(t, func(s *Service) {
+		s.Likes = failingLikes{LikeStore: s.Likes, err: failure}
+	})
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+
+	_, err := ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: post.Id})
+	requireCode(t, err, codes.Internal)
+	if strings.Contains(status.Convert(err).Message(), "secret") {
+		t.Fatalf("Internal error leaked: %v", err)
+	}
+
+	code := ts.rest(t, http.MethodPost, "/like-post", alice.tokens.AccessToken, fmt.Sprintf(`{"postId": %d}`, post.Id), nil)
+	if code != http.StatusInternalServerError {
+		t.Fatalf("POST /like-post: %d", code)
+	}
+}
+
+func TestUnreachableStorageIsUnavailable(t *testing.T) {
+	failure := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	ts := newTestServer(t, func(s *Service) {
+		s.Likes = failingLikes{LikeStore: s.Likes, err: fmt.Errorf("redis: %w", failure)}
+	})
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+
+	_, err := ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: post.Id})
+	requireCode(t, err, codes.Unavailable)
+
+	code := ts.rest(t, http.MethodPost, "/like-post", alice.tokens.AccessToken, fmt.Sprintf(`{"postId": %d}`, post.Id), nil)
+	if code != http.StatusServiceUnavailable {
+		t.Fatalf("POST /like-post: %d", code)
+	}
+}
+
+func TestGatewayErrorCodes(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	post := ts.createPost(t, alice, "Post")
+
+	code := ts.rest(t, http.MethodPut, "/edit-post", bob.tokens.AccessToken, fmt.Sprintf(`{"postId": %d, "post": {"title": "T", "body": "B"}}`, post.Id), nil)
+	if code != http.StatusForbidden {
+		t.Fatalf("PUT /edit-post by another user: %d", code)
+	}
+
+	code = ts.rest(t, http.MethodPut, "/edit-post", alice.tokens.AccessToken, `{"postId": 1000, "post": {"title": "T", "body": "B"}}`, nil)
+	if code != http.StatusNotFound {
+		t.Fatalf("PUT /edit-post of missing post: %d", code)
+	}
+}
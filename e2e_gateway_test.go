@@ -0,0 +1,282 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	api "go_1C/api"
+)
+
+func TestGatewayPosts(t *testing.T) {
+	ts := newTestServer(t)
+
+	var registered api.RegisterRsp
+	code := ts.rest(t, http.MethodPost, "/register", "", `{"login": "alice", "password": "password-alice", "name": "Alice"}`, &registered)
+	if code != http.StatusOK {
+		t.Fatalf("POST /register: %d", code)
+	}
+	token := registered.Tokens.AccessToken
+
+	code = ts.rest(t, http.MethodPost, "/create-post", "", `{"post": {"title": "T", "body": "B"}}`, nil)
+	if code != http.StatusUnauthorized {
+		t.Fatalf("Anonymous POST /create-post: %d", code)
+	}
+
+	var created api.CreatePostRsp
+	code = ts.rest(t, http.MethodPost, "/create-post", token, `{"post": {"title": "Title", "body": "Body"}}`, &created)
+	if code != http.StatusOK || created.Post.Post.Title != "Title" || created.Post.Author.Id != registered.User.Id {
+		t.Fatalf("POST /create-post: %d %v", code, created.Post)
+	}
+	post_id := created.Post.Id
+
+	var second api.CreatePostRsp
+	ts.rest(t, http.MethodPost, "/create-post", token, `{"post": {"title": "Second", "body": "Body"}}`, &second)
+
+	var posts api.GetPostsRsp
+	code = ts.rest(t, http.MethodGet, "/get-posts?limit=1", "", "", &posts)
+	if code != http.StatusOK || !slices.Equal(postIDs(posts.Posts), []int64{post_id}) || posts.NextPageToken == "" {
+		t.Fatalf("GET /get-posts: %d %v", code, &posts)
+	}
+
+	code = ts.rest(t, http.MethodGet, "/get-posts?limit=1&pageToken="+posts.NextPageToken, "", "", &posts)
+	if code != http.StatusOK || !slices.Equal(postIDs(posts.Posts), []int64{second.Post.Id}) {
+		t.Fatalf("GET /get-posts next page: %d %v", code, &posts)
+	}
+
+	code = ts.rest(t, http.MethodGet, "/get-posts?limit=10&sort=POST_SORT_NEWEST", "", "", &posts)
+	if code != http.StatusOK || !slices.Equal(postIDs(posts.Posts), []int64{second.Post.Id, post_id}) {
+		t.Fatalf("GET /get-posts newest: %d %v", code, &posts)
+	}
+
+	code = ts.rest(t, http.MethodGet, "/get-posts?limit=10&pageToken=garbage", "", "", nil)
+	if code != http.StatusBadRequest {
+		t.Fatalf("GET /get-posts with invalid token: %d", code)
+	}
+
+	code = ts.rest(t, http.MethodPost, "/like-post", token, fmt.Sprintf(`{"postId": %d}`, post_id), nil)
+	if code != http.StatusOK {
+		t.Fatalf("POST /like-post: %d", code)
+	}
+
+	code = ts.rest(t, http.MethodPost, "/like-post", token, fmt.Sprintf(`{"postId": %d}`, post_id), nil)
+	if code != http.StatusConflict {
+		t.Fatalf("Repeated POST /like-post: %d", code)
+	}
+
+	code = ts.rest(t, http.MethodGet, "/get-posts?limit=1", token, "", &posts)
+	if code != http.StatusOK || posts.Posts[0].Likes != 1 || !posts.Posts[0].IsLiked {
+		t.Fatalf("GET /get-posts after like: %d %v", code, &posts)
+	}
+
+	code = ts.rest(t, http.MethodDelete, fmt.Sprintf("/dislike-post?postId=%d", post_id), token, "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("DELETE /dislike-post: %d", code)
+	}
+
+	var edited api.EditPostRsp
+	code = ts.rest(t, http.MethodPut, "/edit-post", token, fmt.Sprintf(`{"postId": %d, "post": {"title": "Edited", "body": "Body"}}`, post_id), &edited)
+	if code != http.StatusOK || edited.Post.Post.Title != "Edited" {
+		t.Fatalf("PUT /edit-post: %d %v", code, edited.Post)
+	}
+
+	code = ts.rest(t, http.MethodDelete, fmt.Sprintf("/delete-post?postId=%d", post_id), token, "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("DELETE /delete-post: %d", code)
+	}
+
+	var history api.GetPostHistoryRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-post-history?postId=%d&limit=10", post_id), token, "", &history)
+	if code != http.StatusOK || len(history.Revisions) != 1 {
+		t.Fatalf("GET /get-post-history: %d %v", code, &history)
+	}
+
+	var restored api.RestorePostRsp
+	code = ts.rest(t, http.MethodPost, "/restore-post", token, fmt.Sprintf(`{"postId": %d}`, post_id), &restored)
+	if code != http.StatusOK || restored.Post.Id != post_id {
+		t.Fatalf("POST /restore-post: %d %v", code, restored.Post)
+	}
+
+	code = ts.rest(t, http.MethodPost, "/restore-post", token, fmt.Sprintf(`{"postId": %d}`, post_id), nil)
+	if code != http.StatusNotFound {
+		t.Fatalf("Repeated POST /restore-post: %d", code)
+	}
+}
+
+func TestGatewayComments(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+	token := alice.tokens.AccessToken
+
+	var created api.CreateCommentRsp
+	code := ts.rest(t, http.MethodPost, "/create-comment", token, fmt.Sprintf(`{"postId": %d, "body": "Comment"}`, post.Id), &created)
+	if code != http.StatusOK || created.Comment.Body != "Comment" {
+		t.Fatalf("POST /create-comment: %d %v", code, created.Comment)
+	}
+	comment_id := created.Comment.Id
+
+	var reply api.CreateCommentRsp
+	code = ts.rest(t, http.MethodPost, "/create-comment", token, fmt.Sprintf(`{"postId": %d, "parentCommentId": %d, "body": "Reply"}`, post.Id, comment_id), &reply)
+	if code != http.StatusOK || reply.Comment.ParentCommentId != comment_id {
+		t.Fatalf("POST /create-comment reply: %d %v", code, reply.Comment)
+	}
+
+	var comments api.GetCommentsRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-comments?postId=%d&limit=10", post.Id), "", "", &comments)
+	if code != http.StatusOK || len(comments.Comments) != 2 {
+		t.Fatalf("GET /get-comments: %d %v", code, &comments)
+	}
+
+	var thread api.GetCommentThreadRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-comment-thread?postId=%d&limit=10", post.Id), "", "", &thread)
+	if code != http.StatusOK || len(thread.Comments) != 1 || len(thread.Comments[0].Replies) != 1 {
+		t.Fatalf("GET /get-comment-thread: %d %v", code, &thread)
+	}
+
+	code = ts.rest(t, http.MethodPost, "/like-comment", token, fmt.Sprintf(`{"commentId": %d}`, comment_id), nil)
+	if code != http.StatusOK {
+		t.Fatalf("POST /like-comment: %d", code)
+	}
+
+	code = ts.rest(t, http.MethodDelete, fmt.Sprintf("/dislike-comment?commentId=%d", comment_id), token, "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("DELETE /dislike-comment: %d", code)
+	}
+
+	code = ts.rest(t, http.MethodDelete, fmt.Sprintf("/dislike-comment?commentId=%d", comment_id), token, "", nil)
+	if code != http.StatusConflict {
+		t.Fatalf("Repeated DELETE /dislike-comment: %d", code)
+	}
+
+	var edited api.EditCommentRsp
+	code = ts.rest(t, http.MethodPut, "/edit-comment", token, fmt.Sprintf(`{"commentId": %d, "body": "Edited"}`, comment_id), &edited)
+	if code != http.StatusOK || edited.Comment.Body != "Edited" {
+		t.Fatalf("PUT /edit-comment: %d %v", code, edited.Comment)
+	}
+
+	var history api.GetCommentHistoryRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-comment-history?commentId=%d&limit=10", comment_id), "", "", &history)
+	if code != http.StatusOK || len(history.Revisions) != 1 {
+		t.Fatalf("GET /get-comment-history: %d %v", code, &history)
+	}
+
+	code = ts.rest(t, http.MethodDelete, fmt.Sprintf("/delete-comment?commentId=%d", reply.Comment.Id), token, "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("DELETE /delete-comment: %d", code)
+	}
+
+	var restored api.RestoreCommentRsp
+	code = ts.rest(t, http.MethodPost, "/restore-comment", token, fmt.Sprintf(`{"commentId": %d}`, reply.Comment.Id), &restored)
+	if code != http.StatusOK || restored.Comment.Body != "Reply" {
+		t.Fatalf("POST /restore-comment: %d %v", code, restored.Comment)
+	}
+
+	var found api.SearchCommentsRsp
+	code = ts.rest(t, http.MethodGet, "/search-comments?query=edited&limit=10", "", "", &found)
+	if code != http.StatusOK || len(found.Results) != 1 {
+		t.Fatalf("GET /search-comments: %d %v", code, &found)
+	}
+}
+
+func TestGatewayUsers(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	post := ts.createPost(t, alice, "Gophers")
+
+	var logged_in api.LoginRsp
+	code := ts.rest(t, http.MethodPost, "/login", "", `{"login": "bob", "password": "password-bob"}`, &logged_in)
+	if code != http.StatusOK || logged_in.User.Id != bob.id {
+		t.Fatalf("POST /login: %d %v", code, &logged_in)
+	}
+	token := logged_in.Tokens.AccessToken
+
+	code = ts.rest(t, http.MethodPost, "/login", "", `{"login": "bob", "password": "wrong"}`, nil)
+	if code != http.StatusUnauthorized {
+		t.Fatalf("POST /login with wrong password: %d", code)
+	}
+
+	var profile api.UpdateProfileRsp
+	code = ts.rest(t, http.MethodPut, "/update-profile", token, `{"bio": "Hello"}`, &profile)
+	if code != http.StatusOK || profile.User.Bio != "Hello" {
+		t.Fatalf("PUT /update-profile: %d %v", code, &profile)
+	}
+
+	var user api.GetUserRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-user?id=%d", bob.id), "", "", &user)
+	if code != http.StatusOK || user.User.Bio != "Hello" {
+		t.Fatalf("GET /get-user: %d %v", code, &user)
+	}
+
+	code = ts.rest(t, http.MethodPost, "/follow", token, fmt.Sprintf(`{"followeeId": %d}`, alice.id), nil)
+	if code != http.StatusOK {
+		t.Fatalf("POST /follow: %d", code)
+	}
+
+	var followers api.ListFollowersRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-followers?id=%d&limit=10", alice.id), "", "", &followers)
+	if code != http.StatusOK || len(followers.Users) != 1 {
+		t.Fatalf("GET /get-followers: %d %v", code, &followers)
+	}
+
+	var following api.ListFollowingRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-following?id=%d&limit=10", bob.id), "", "", &following)
+	if code != http.StatusOK || len(following.Users) != 1 {
+		t.Fatalf("GET /get-following: %d %v", code, &following)
+	}
+
+	var feed api.GetFeedRsp
+	code = ts.rest(t, http.MethodGet, "/get-feed?limit=10", token, "", &feed)
+	if code != http.StatusOK || !slices.Equal(postIDs(feed.Posts), []int64{post.Id}) {
+		t.Fatalf("GET /get-feed: %d %v", code, &feed)
+	}
+
+	code = ts.rest(t, http.MethodDelete, fmt.Sprintf("/unfollow?followeeId=%d", alice.id), token, "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("DELETE /unfollow: %d", code)
+	}
+
+	var user_posts api.ListUserPostsRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-user-posts?authorId=%d&limit=10", alice.id), "", "", &user_posts)
+	if code != http.StatusOK || len(user_posts.Posts) != 1 {
+		t.Fatalf("GET /get-user-posts: %d %v", code, &user_posts)
+	}
+
+	var user_comments api.ListUserCommentsRsp
+	code = ts.rest(t, http.MethodGet, fmt.Sprintf("/get-user-comments?authorId=%d&limit=10", alice.id), "", "", &user_comments)
+	if code != http.StatusOK || len(user_comments.Comments) != 0 {
+		t.Fatalf("GET /get-user-comments: %d %v", code, &user_comments)
+	}
+
+	var found api.SearchPostsRsp
+	code = ts.rest(t, http.MethodGet, "/search-posts?query=gophers&limit=10", "", "", &found)
+	if code != http.StatusOK || len(found.Results) != 1 {
+		t.Fatalf("GET /search-posts: %d %v", code, &found)
+	}
+
+	var refreshed api.RefreshTokenRsp
+	code = ts.rest(t, http.MethodPost, "/refresh-token", "", fmt.Sprintf(`{"refreshToken": "%s"}`, logged_in.Tokens.RefreshToken), &refreshed)
+	if code != http.StatusOK || refreshed.Tokens.AccessToken == "" {
+		t.Fatalf("POST /refresh-token: %d %v", code, &refreshed)
+	}
+
+	code = ts.rest(t, http.MethodPost, "/logout", token, fmt.Sprintf(`{"refreshToken": "%s"}`, refreshed.Tokens.RefreshToken), nil)
+	if code != http.StatusOK {
+		t.Fatalf("POST /logout: %d", code)
+	}
+}
+
+func TestGatewaySwagger(t *testing.T) {
+	ts := newTestServer(t)
+
+	rsp, err := ts.gateway.Client().Get(ts.gateway.URL + "/swagger-ui/swagger.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /swagger-ui/swagger.json: %d", rsp.StatusCode)
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	api "go_1C/api"
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+// Latency of one request to redis and number of connections to it emulated
+// by roundTripLikes. The pool is shared with other requests to the service,
+// so a request gets only a few connections.
+const bench_round_trip = 100 * time.Microsecond
+const bench_pool_size = 4
+
+// roundTripLikes makes every read of likes cost one round trip to redis
+// holding one connection of the pool.
+type roundTripLikes struct {
+	storage.LikeStore
+	roundTrips *atomic.Int64
+	pool       chan struct{}
+}
+
+func (l roundTripLikes) roundTrip() {
+	l.roundTrips.Add(1)
+	l.pool <- struct{}{}
+	time.Sleep(bench_round_trip)
+	<-l.pool
+}
+
+func (l roundTripLikes) PostLikes(ctx context.Context, post_id uint, user_id int64) (int64, bool, error) {
+	l.roundTrip()
+	return l.LikeStore.PostLikes(ctx, post_id, user_id)
+}
+
+func (l roundTripLikes) PostsLikes(ctx context.Context, post_ids []uint, user_id int64) ([]storage.Likes, error) {
+	l.roundTrip()
+	return l.LikeStore.PostsLikes(ctx, post_ids, user_id)
+}
+
+func (l roundTripLikes) CommentLikes(ctx context.Context, comment_id uint, user_id int64) (int64, bool, error) {
+	l.roundTrip()
+	return l.LikeStore.CommentLikes(ctx, comment_id, user_id)
+}
+
+func (l roundTripLikes) CommentsLikes(ctx context.Context, comment_ids []uint, user_id int64) ([]storage.Likes, error) {
+	l.roundTrip()
+	return l.LikeStore.CommentsLikes(ctx, comment_ids, user_id)
+}
+
+// perRowPostsToAPI is the former hydration of posts with a goroutine and a
+// likes request per post, kept to compare with postsToAPI. Other stores are
+// asked the same way as postsToAPI does.
+func (s *Service) perRowPostsToAPI(ctx context.Context, posts []models.Post, user_id int64) ([]*api.Post, error) {
+	var wg sync.WaitGroup
+	posts_rsp := make([]*api.Post, len(posts))
+	errs := make(chan error, len(posts))
+
+	for i, post := range posts {
+		wg.Add(1)
+		go func(i int, post models.Post) {
+			defer wg.Done()
+			likes, is_liked, err := s.Likes.PostLikes(ctx, post.ID, user_id)
+			if err != nil {
+				errs <- err
+				return
+			}
+			posts_rsp[i] = postToAPI(&post, likes, is_liked)
+		}(i, post)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	if err := s.fillPostReactions(ctx, posts_rsp, user_id); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostVotes(ctx, posts_rsp, user_id); err != nil {
+		return nil, err
+	}
+	if err := s.fillLikedBy(ctx, posts_rsp, user_id); err != nil {
+		return nil, err
+	}
+	return posts_rsp, nil
+}
+
+// perRowCommentsToAPI is the former hydration of comments, see perRowPostsToAPI.
+func (s *Service) perRowCommentsToAPI(ctx context.Context, comments []models.Comment, user_id int64) ([]*api.Comment, error) {
+	var wg sync.WaitGroup
+	comments_rsp := make([]*api.Comment, len(comments))
+	errs := make(chan error, len(comments))
+
+	for i, comment := range comments {
+		wg.Add(1)
+		go func(i int, comment models.Comment) {
+			defer wg.Done()
+			likes, is_liked, err := s.Likes.CommentLikes(ctx, comment.ID, user_id)
+			if err != nil {
+				errs <- err
+				return
+			}
+			comments_rsp[i] = commentToAPI(&comment, likes, is_liked)
+		}(i, comment)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	if err := s.fillReplyCounts(ctx, comments_rsp); err != nil {
+		return nil, err
+	}
+	if err := s.fillCommentReactions(ctx, comments_rsp, user_id); err != nil {
+		return nil, err
+	}
+	if err := s.fillCommentVotes(ctx, comments_rsp, user_id); err != nil {
+		return nil, err
+	}
+	return comments_rsp, nil
+}
+
+// newBenchService returns memory service with a page of liked posts and a
+// page of liked comments.
+func newBenchService(b *testing.B, size int, round_trips *atomic.Int64) (*Service, []models.Post, []models.Comment) {
+	b.Helper()
+
+	s := newMemoryService()
+	s.Logger = zap.NewNop()
+	s.LikesLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "likes_latency"}, []string{"likes_latency"})
+	ctx := context.Background()
+
+	user := &models.User{Name: "bench"}
+	if err := s.Users.Create(ctx, user, &models.Credentials{Login: "bench"}); err != nil {
+		b.Fatal(err)
+	}
+
+	for i := 0; i < size; i++ {
+		post := &models.Post{Title: fmt.Sprint("Post ", i), Body: "Body", AuthorID: user.ID}
+		if err := s.Posts.Create(ctx, post); err != nil {
+			b.Fatal(err)
+		}
+		comment := &models.Comment{PostRefer: post.ID, AuthorID: user.ID, Body: "Comment"}
+		if err := s.Comments.Create(ctx, comment); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := s.Likes.LikePost(ctx, post.ID, int64(user.ID)); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := s.Likes.LikeComment(ctx, comment.ID, int64(user.ID)); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	posts, err := s.Posts.Find(ctx, storage.PostQuery{Limit: int64(size)})
+	if err != nil {
+		b.Fatal(err)
+	}
+	comments, err := s.Comments.ListByAuthor(ctx, user.ID, 0, int64(size))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	s.Likes = roundTripLikes{LikeStore: s.Likes, roundTrips: round_trips, pool: make(chan struct{}, bench_pool_size)}
+	return s, posts, comments
+}
+
+// BenchmarkHydratePosts compares likes requests per post with one batch
+// request per page, round_trips/op is the number of requests to redis.
+func BenchmarkHydratePosts(b *testing.B) {
+	for _, size := range []int{10, 100} {
+		for _, hydrate := range []struct {
+			name string
+			run  func(s *Service, posts []models.Post) error
+		}{
+			{"PerRow", func(s *Service, posts []models.Post) error {
+				_, err := s.perRowPostsToAPI(context.Background(), posts, 1)
+				return err
+			}},
+			{"Batch", func(s *Service, posts []models.Post) error {
+				_, err := s.postsToAPI(context.Background(), posts, 1)
+				return err
+			}},
+		} {
+			b.Run(fmt.Sprintf("%s/%d", hydrate.name, size), func(b *testing.B) {
+				var round_trips atomic.Int64
+				s, posts, _ := newBenchService(b, size, &round_trips)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if err := hydrate.run(s, posts); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(round_trips.Load())/float64(b.N), "round_trips/op")
+			})
+		}
+	}
+}
+
+func BenchmarkHydrateComments(b *testing.B) {
+	for _, size := range []int{10, 100} {
+		for _, hydrate := range []struct {
+			name string
+			run  func(s *Service, comments []models.Comment) error
+		}{
+			{"PerRow", func(s *Service, comments []models.Comment) error {
+				_, err := s.perRowCommentsToAPI(context.Background(), comments, 1)
+				return err
+			}},
+			{"Batch", func(s *Service, comments []models.Comment) error {
+				_, err := s.commentsToAPI(context.Background(), comments, 1)
+				return err
+			}},
+		} {
+			b.Run(fmt.Sprintf("%s/%d", hydrate.name, size), func(b *testing.B) {
+				var round_trips atomic.Int64
+				s, _, comments := newBenchService(b, size, &round_trips)
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if err := hydrate.run(s, comments); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(round_trips.Load())/float64(b.N), "round_trips/op")
+			})
+		}
+	}
+}
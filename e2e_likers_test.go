@@ -0,0 +1,109 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	api "go_1C/api"
+)
+
+func userIDs(users []*api.UserInfo) []int64 {
+	ids := make([]int64, len(users))
+	for i, user := range users {
+		ids[i] = user.Id
+	}
+	return ids
+}
+
+func TestListLikers(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	carol := ts.register(t, "carol")
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	for _, user := range []*testUser{bob, carol, alice} {
+		_, err := ts.client.LikePost(user.ctx, &api.LikePostReq{PostId: post.Id})
+		requireOK(t, err)
+	}
+	_, err := ts.client.DislikePost(alice.ctx, &api.DislikePostReq{PostId: post.Id})
+	requireOK(t, err)
+	_, err = ts.client.LikeComment(bob.ctx, &api.LikeCommentReq{CommentId: comment.Id})
+	requireOK(t, err)
+
+	rsp, err := ts.client.ListPostLikers(alice.ctx, &api.ListPostLikersReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	if ids := userIDs(rsp.Users); !slices.Equal(ids, []int64{carol.id, bob.id}) {
+		t.Fatalf("Expected likers %d, %d, got %v", carol.id, bob.id, ids)
+	}
+	if rsp.Users[0].Name != "Name carol" {
+		t.Fatalf("Unexpected liker %v", rsp.Users[0])
+	}
+
+	rsp, err = ts.client.ListPostLikers(alice.ctx, &api.ListPostLikersReq{PostId: post.Id, Offset: 1, Limit: 1})
+	requireOK(t, err)
+	if ids := userIDs(rsp.Users); !slices.Equal(ids, []int64{bob.id}) {
+		t.Fatalf("Expected liker %d, got %v", bob.id, ids)
+	}
+
+	comment_rsp, err := ts.client.ListCommentLikers(alice.ctx, &api.ListCommentLikersReq{CommentId: comment.Id, Limit: 10})
+	requireOK(t, err)
+	if ids := userIDs(comment_rsp.Users); !slices.Equal(ids, []int64{bob.id}) {
+		t.Fatalf("Expected liker %d, got %v", bob.id, ids)
+	}
+
+	_, err = ts.client.ListPostLikers(alice.ctx, &api.ListPostLikersReq{PostId: 1000, Limit: 10})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.ListCommentLikers(alice.ctx, &api.ListCommentLikersReq{CommentId: 1000, Limit: 10})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestLikedByFollowees(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	carol := ts.register(t, "carol")
+	dave := ts.register(t, "dave")
+	post := ts.createPost(t, alice, "Post")
+	ts.createPost(t, alice, "Not liked")
+
+	for _, user := range []*testUser{bob, carol, dave} {
+		_, err := ts.client.LikePost(user.ctx, &api.LikePostReq{PostId: post.Id})
+		requireOK(t, err)
+	}
+	for _, followee := range []*testUser{bob, carol} {
+		_, err := ts.client.Follow(alice.ctx, &api.FollowReq{FolloweeId: followee.id})
+		requireOK(t, err)
+	}
+
+	for _, sort := range []api.PostSort{api.PostSort_POST_SORT_OLDEST, api.PostSort_POST_SORT_NEWEST} {
+		rsp, err := ts.client.GetPosts(alice.ctx, &api.GetPostsReq{Limit: 10, Sort: sort})
+		requireOK(t, err)
+
+		for _, p := range rsp.Posts {
+			if p.Id != post.Id {
+				if p.LikedBy != nil {
+					t.Fatalf("Post %d is not liked, got %v", p.Id, p.LikedBy)
+				}
+				continue
+			}
+			// carol liked after bob, dave is not followed
+			if p.LikedBy.GetUser().GetId() != carol.id || p.LikedBy.GetOthers() != 2 {
+				t.Fatalf("Expected liked by %d and 2 others, got %v", carol.id, p.LikedBy)
+			}
+		}
+	}
+
+	// nobody of followees of bob liked the post
+	rsp, err := ts.client.GetPosts(bob.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	for _, p := range rsp.Posts {
+		if p.LikedBy != nil {
+			t.Fatalf("Unexpected liked by %v", p.LikedBy)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"go_1C/storage/postgres/migrations"
+)
+
+// Schema created by gorm AutoMigrate from the first version of models.go,
+// before timestamps, soft delete, replies and search were added.
+const baseline_schema = `
+CREATE TABLE users (
+    id bigserial PRIMARY KEY,
+    name text
+);
+
+CREATE TABLE posts (
+    id bigserial PRIMARY KEY,
+    title text,
+    body text NOT NULL,
+    author_id bigint NOT NULL,
+    CONSTRAINT fk_posts_author FOREIGN KEY (author_id) REFERENCES users (id)
+);
+
+CREATE TABLE comments (
+    id bigserial PRIMARY KEY,
+    post_refer bigint NOT NULL,
+    author_id bigint NOT NULL,
+    body text NOT NULL,
+    CONSTRAINT fk_posts_comments FOREIGN KEY (post_refer) REFERENCES posts (id),
+    CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users (id)
+);
+
+INSERT INTO users (id, name) VALUES (1, 'Alice');
+INSERT INTO posts (id, title, body, author_id) VALUES (1, 'Hello', 'First post', 1);
+INSERT INTO comments (id, post_refer, author_id, body) VALUES (1, 1, 1, 'First comment');
+`
+
+// openTestPostgres connects to the database of TEST_POSTGRES_DSN and empties
+// it. The test is skipped if the variable is not set, the database is
+// dropped, so it must not be used for anything else.
+func openTestPostgres(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn, exists := os.LookupEnv("TEST_POSTGRES_DSN")
+	if !exists {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+
+	gorm_db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to postgres: %v", err)
+	}
+	sql_db, err := gorm_db.DB()
+	if err != nil {
+		t.Fatalf("Failed to get postgres connection: %v", err)
+	}
+	t.Cleanup(func() { sql_db.Close() })
+
+	if _, err := sql_db.Exec("DROP SCHEMA public CASCADE; CREATE SCHEMA public"); err != nil {
+		t.Fatalf("Failed to empty database: %v", err)
+	}
+	return sql_db
+}
+
+func TestMigrateBaselineSchema(t *testing.T) {
+	sql_db := openTestPostgres(t)
+	ctx := context.Background()
+
+	if _, err := sql_db.Exec(baseline_schema); err != nil {
+		t.Fatalf("Failed to create baseline schema: %v", err)
+	}
+
+	migrator, err := migrations.New(sql_db)
+	if err != nil {
+		t.Fatalf("Failed to load migrations: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Failed to migrate baseline schema: %v", err)
+	}
+
+	columns := map[string][]string{
+		"users":    {"bio", "avatar_url"},
+		"posts":    {"created_at", "updated_at", "edited_at", "deleted_at", "search_vector", "likes_count", "comments_count"},
+		"comments": {"parent_id", "created_at", "updated_at", "edited_at", "deleted_at", "search_vector", "likes_count"},
+	}
+	for table, names := range columns {
+		for _, name := range names {
+			var count int
+			err := sql_db.QueryRow("SELECT count(*) FROM information_schema.columns WHERE table_schema = 'public' AND table_name = $1 AND column_name = $2", table, name).Scan(&count)
+			if err != nil {
+				t.Fatalf("Failed to check column %s.%s: %v", table, name, err)
+			}
+			if count != 1 {
+				t.Errorf("Column %s.%s is missing", table, name)
+			}
+		}
+	}
+
+	var comments_count int64
+	var has_created_at bool
+	err = sql_db.QueryRow("SELECT comments_count, created_at IS NOT NULL FROM posts WHERE id = 1").Scan(&comments_count, &has_created_at)
+	if err != nil {
+		t.Fatalf("Failed to read migrated post: %v", err)
+	}
+	if comments_count != 1 || !has_created_at {
+		t.Errorf("Migrated post has comments_count %d and created_at set %v, want 1 and true", comments_count, has_created_at)
+	}
+
+	// every migration must roll back and apply again on the migrated data
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get migrations status: %v", err)
+	}
+	if _, err := migrator.Down(ctx, len(statuses)); err != nil {
+		t.Fatalf("Failed to roll back migrations: %v", err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Failed to migrate empty database: %v", err)
+	}
+}
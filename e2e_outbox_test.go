@@ -0,0 +1,209 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	api "go_1C/api"
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+// flakyFeed records posts delivered to feeds. It fails to take them out
+// while broken is set, and always fails for post_id.
+type flakyFeed struct {
+	storage.FeedStore
+	broken    *bool
+	post_id   uint
+	delivered map[uint]bool
+}
+
+func (f flakyFeed) AddPost(ctx context.Context, post *models.Post) error {
+	f.delivered[post.ID] = true
+	return f.FeedStore.AddPost(ctx, post)
+}
+
+func (f flakyFeed) RemovePost(ctx context.Context, post *models.Post) error {
+	if *f.broken || post.ID == f.post_id {
+		return errors.New("redis is down")
+	}
+	delete(f.delivered, post.ID)
+	return f.FeedStore.RemovePost(ctx, post)
+}
+
+func TestLikesOfMissingTargets(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	_, err := ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: 1000})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.DislikePost(alice.ctx, &api.DislikePostReq{PostId: 1000})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.LikeComment(alice.ctx, &api.LikeCommentReq{CommentId: 1000})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.DeleteComment(alice.ctx, &api.DeleteCommentReq{CommentId: comment.Id})
+	requireOK(t, err)
+	_, err = ts.client.LikeComment(alice.ctx, &api.LikeCommentReq{CommentId: comment.Id})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: post.Id})
+	requireOK(t, err)
+	_, err = ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: post.Id})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestOutboxUpdatesFeeds(t *testing.T) {
+	var s *Service
+	broken := false
+	feed := flakyFeed{broken: &broken, delivered: map[uint]bool{}}
+	ts := newTestServer(t, func(service *Service) {
+		s = service
+		feed.FeedStore = s.Feed
+		s.Feed = feed
+	})
+	ctx := context.Background()
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+
+	if err := s.processOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !feed.delivered[uint(post.Id)] {
+		t.Fatal("New post is not delivered to feeds")
+	}
+
+	broken = true
+	_, err := ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: post.Id})
+	requireOK(t, err)
+	if err := s.processOutbox(ctx); err == nil {
+		t.Fatal("Expected outbox to fail")
+	}
+	if !feed.delivered[uint(post.Id)] {
+		t.Fatal("Failed event is applied")
+	}
+
+	// failed event is retried and the next one waits for it
+	_, err = ts.client.RestorePost(alice.ctx, &api.RestorePostReq{PostId: post.Id})
+	requireOK(t, err)
+	_, err = ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: post.Id})
+	requireOK(t, err)
+
+	broken = false
+	if err := s.processOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if feed.delivered[uint(post.Id)] {
+		t.Fatal("Deleted post is left in feeds")
+	}
+
+	_, err = ts.client.RestorePost(alice.ctx, &api.RestorePostReq{PostId: post.Id})
+	requireOK(t, err)
+	if err := s.processOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !feed.delivered[uint(post.Id)] {
+		t.Fatal("Restored post is not delivered to feeds")
+	}
+
+	applied, err := s.Outbox.Process(ctx, outbox_batch_size, func(event models.OutboxEvent) error { return nil })
+	if err != nil || applied != 0 {
+		t.Fatalf("Outbox is not empty: %d %v", applied, err)
+	}
+}
+
+func TestReconcileLikes(t *testing.T) {
+	var s *Service
+	ts := newTestServer(t, func(service *Service) { s = service })
+	ctx := context.Background()
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	_, err := ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: post.Id})
+	requireOK(t, err)
+	_, err = ts.client.LikeComment(alice.ctx, &api.LikeCommentReq{CommentId: comment.Id})
+	requireOK(t, err)
+
+	// likes stored before existence was checked
+	if _, err := s.Likes.LikePost(ctx, 1000, alice.id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Likes.LikeComment(ctx, 2000, alice.id); err != nil {
+		t.Fatal(err)
+	}
+
+	// likes of deleted post are kept for restore
+	_, err = ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: post.Id})
+	requireOK(t, err)
+
+	if err := s.reconcileLikes(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	post_ids, err := s.Likes.LikedPostIDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(post_ids, []uint{uint(post.Id)}) {
+		t.Fatalf("Expected likes of post %d only, got %v", post.Id, post_ids)
+	}
+
+	comment_ids, err := s.Likes.LikedCommentIDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(comment_ids, []uint{uint(comment.Id)}) {
+		t.Fatalf("Expected likes of comment %d only, got %v", comment.Id, comment_ids)
+	}
+}
+
+func TestOutboxSkipsDeadEvent(t *testing.T) {
+	var s *Service
+	ts := newTestServer(t, func(service *Service) { s = service })
+	ctx := context.Background()
+	alice := ts.register(t, "alice")
+	broken := ts.createPost(t, alice, "Broken")
+	post := ts.createPost(t, alice, "Post")
+	feed := flakyFeed{FeedStore: s.Feed, broken: new(bool), post_id: uint(broken.Id), delivered: map[uint]bool{}}
+	s.Feed = feed
+	// new posts are delivered before they are deleted
+	if err := s.processOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []*api.Post{broken, post} {
+		_, err := ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: p.Id})
+		requireOK(t, err)
+	}
+
+	for attempt := 1; attempt < storage.MaxEventAttempts; attempt++ {
+		if err := s.processOutbox(ctx); err == nil {
+			t.Fatalf("Expected outbox to fail on attempt %d", attempt)
+		}
+	}
+	if !feed.delivered[uint(post.Id)] {
+		t.Fatal("Event after the failed one is applied")
+	}
+
+	// the last attempt makes the event dead and the next one is applied
+	if err := s.processOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !feed.delivered[uint(broken.Id)] || feed.delivered[uint(post.Id)] {
+		t.Fatalf("Unexpected posts in feeds after dead event: %v", feed.delivered)
+	}
+
+	applied, err := s.Outbox.Process(ctx, outbox_batch_size, func(event models.OutboxEvent) error { return nil })
+	if err != nil || applied != 0 {
+		t.Fatalf("Outbox is not empty: %d %v", applied, err)
+	}
+}
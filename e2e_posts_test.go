@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	api "go_1C/api"
+)
+
+func TestPostLifecycle(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	post := ts.createPost(t, alice, "First")
+	if post.Author.Id != alice.id || post.Post.Title != "First" || post.EditedAt != nil {
+		t.Fatalf("Unexpected post: %v", post)
+	}
+
+	edited, err := ts.client.EditPost(alice.ctx, &api.EditPostReq{PostId: post.Id, Post: &api.PostBody{Title: "First, edited", Body: "New body"}})
+	requireOK(t, err)
+	if edited.Post.Post.Title != "First, edited" || edited.Post.EditedAt == nil {
+		t.Fatalf("Unexpected edited post: %v", edited.Post)
+	}
+
+	_, err = ts.client.EditPost(bob.ctx, &api.EditPostReq{PostId: post.Id, Post: &api.PostBody{Title: "Bob", Body: "Bob"}})
+	requireCode(t, err, codes.PermissionDenied)
+
+	_, err = ts.client.EditPost(alice.ctx, &api.EditPostReq{PostId: post.Id, Post: &api.PostBody{Title: strings.Repeat("t", 101), Body: "B"}})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.CreatePost(alice.ctx, &api.CreatePostReq{Post: &api.PostBody{Title: strings.Repeat("t", 101), Body: "B"}})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.CreatePost(alice.ctx, &api.CreatePostReq{})
+	requireCode(t, err, codes.InvalidArgument)
+
+	history, err := ts.client.GetPostHistory(context.Background(), &api.GetPostHistoryReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	if len(history.Revisions) != 1 || history.Revisions[0].Post.Title != "First" {
+		t.Fatalf("Unexpected history: %v", history.Revisions)
+	}
+
+	_, err = ts.client.DeletePost(bob.ctx, &api.DeletePostReq{PostId: post.Id})
+	requireCode(t, err, codes.PermissionDenied)
+
+	_, err = ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: post.Id})
+	requireOK(t, err)
+
+	posts, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if len(posts.Posts) != 0 {
+		t.Fatalf("Deleted post is listed: %v", posts.Posts)
+	}
+
+	// history of deleted post is visible to its author only
+	_, err = ts.client.GetPostHistory(bob.ctx, &api.GetPostHistoryReq{PostId: post.Id, Limit: 10})
+	requireCode(t, err, codes.NotFound)
+	_, err = ts.client.GetPostHistory(alice.ctx, &api.GetPostHistoryReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+
+	_, err = ts.client.RestorePost(bob.ctx, &api.RestorePostReq{PostId: post.Id})
+	requireCode(t, err, codes.PermissionDenied)
+
+	restored, err := ts.client.RestorePost(alice.ctx, &api.RestorePostReq{PostId: post.Id})
+	requireOK(t, err)
+	if restored.Post.Id != post.Id || restored.Post.Post.Title != "First, edited" {
+		t.Fatalf("Unexpected restored post: %v", restored.Post)
+	}
+
+	_, err = ts.client.RestorePost(alice.ctx, &api.RestorePostReq{PostId: post.Id})
+	requireCode(t, err, codes.NotFound)
+
+	posts, err = ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if !slices.Equal(postIDs(posts.Posts), []int64{post.Id}) {
+		t.Fatalf("Restored post is not listed: %v", posts.Posts)
+	}
+}
+
+func TestPostLikes(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	post := ts.createPost(t, alice, "Liked")
+
+	// main page is cached, likes must show up right away anyway
+	_, err := ts.client.GetPosts(bob.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+
+	_, err = ts.client.LikePost(bob.ctx, &api.LikePostReq{PostId: post.Id})
+	requireOK(t, err)
+
+	_, err = ts.client.LikePost(bob.ctx, &api.LikePostReq{PostId: post.Id})
+	requireCode(t, err, codes.AlreadyExists)
+
+	_, err = ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: post.Id})
+	requireOK(t, err)
+
+	posts, err := ts.client.GetPosts(bob.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if posts.Posts[0].Likes != 2 || !posts.Posts[0].IsLiked {
+		t.Fatalf("Unexpected likes: %v", posts.Posts[0])
+	}
+
+	// is_liked is personal even though the page is cached
+	posts, err = ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if posts.Posts[0].Likes != 2 || posts.Posts[0].IsLiked {
+		t.Fatalf("Unexpected likes of anonymous: %v", posts.Posts[0])
+	}
+
+	_, err = ts.client.DislikePost(bob.ctx, &api.DislikePostReq{PostId: post.Id})
+	requireOK(t, err)
+
+	_, err = ts.client.DislikePost(bob.ctx, &api.DislikePostReq{PostId: post.Id})
+	requireCode(t, err, codes.AlreadyExists)
+
+	posts, err = ts.client.GetPosts(bob.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if posts.Posts[0].Likes != 1 || posts.Posts[0].IsLiked {
+		t.Fatalf("Unexpected likes after dislike: %v", posts.Posts[0])
+	}
+}
+
+func TestGetPostsPagination(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+
+	var ids []int64
+	for _, title := range []string{"P1", "P2", "P3", "P4", "P5"} {
+		ids = append(ids, ts.createPost(t, alice, title).Id)
+	}
+
+	t.Run("PageTokens", func(t *testing.T) {
+		var got []int64
+		req := &api.GetPostsReq{Limit: 2}
+		for {
+			rsp, err := ts.client.GetPosts(context.Background(), req)
+			requireOK(t, err)
+			got = append(got, postIDs(rsp.Posts)...)
+			if rsp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = rsp.NextPageToken
+		}
+		if !slices.Equal(got, ids) {
+			t.Fatalf("Expected %v, got %v", ids, got)
+		}
+	})
+
+	t.Run("NewestPageTokens", func(t *testing.T) {
+		var got []int64
+		req := &api.GetPostsReq{Limit: 3, Sort: api.PostSort_POST_SORT_NEWEST}
+		for {
+			rsp, err := ts.client.GetPosts(context.Background(), req)
+			requireOK(t, err)
+			got = append(got, postIDs(rsp.Posts)...)
+			if rsp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = rsp.NextPageToken
+		}
+		expected := slices.Clone(ids)
+		slices.Reverse(expected)
+		if !slices.Equal(got, expected) {
+			t.Fatalf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("ExactLastPage", func(t *testing.T) {
+		// the last full page still has a token, the page after it is empty
+		rsp, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 5})
+		requireOK(t, err)
+		if rsp.NextPageToken == "" {
+			t.Fatal("Expected token of the next page")
+		}
+
+		rsp, err = ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 5, PageToken: rsp.NextPageToken})
+		requireOK(t, err)
+		if len(rsp.Posts) != 0 || rsp.NextPageToken != "" {
+			t.Fatalf("Expected empty last page, got %v", rsp)
+		}
+	})
+
+	t.Run("OffsetPastEnd", func(t *testing.T) {
+		rsp, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Offset: 10, Limit: 5})
+		requireOK(t, err)
+		if len(rsp.Posts) != 0 {
+			t.Fatalf("Expected no posts, got %v", rsp.Posts)
+		}
+	})
+
+	t.Run("Offset", func(t *testing.T) {
+		rsp, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Offset: 3, Limit: 5})
+		requireOK(t, err)
+		if !slices.Equal(postIDs(rsp.Posts), ids[3:]) {
+			t.Fatalf("Expected %v, got %v", ids[3:], postIDs(rsp.Posts))
+		}
+	})
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		_, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 0})
+		requireCode(t, err, codes.InvalidArgument)
+
+		_, err = ts.client.GetPosts(context.Background(), &api.GetPostsReq{Offset: -1, Limit: 5})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("InvalidPageToken", func(t *testing.T) {
+		_, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 5, PageToken: "garbage"})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("PageTokenOfRatedSort", func(t *testing.T) {
+		rsp, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 2})
+		requireOK(t, err)
+
+		_, err = ts.client.GetPosts(context.Background(), &api.GetPostsReq{
+			Limit: 2, Sort: api.PostSort_POST_SORT_TOP_LIKED, PageToken: rsp.NextPageToken,
+		})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+
+	t.Run("PageTokenOfAnotherSort", func(t *testing.T) {
+		rsp, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 2, Sort: api.PostSort_POST_SORT_NEWEST})
+		requireOK(t, err)
+
+		_, err = ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 2, PageToken: rsp.NextPageToken})
+		requireCode(t, err, codes.InvalidArgument)
+
+		// comments are paged from the oldest one
+		_, err = ts.client.GetComments(context.Background(), &api.GetCommentsReq{PostId: ids[0], Limit: 2, PageToken: rsp.NextPageToken})
+		requireCode(t, err, codes.InvalidArgument)
+	})
+}
+
+func TestGetPostsSortsAndFilters(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	p1 := ts.createPost(t, alice, "P1")
+	p2 := ts.createPost(t, bob, "P2")
+	p3 := ts.createPost(t, alice, "P3")
+
+	_, err := ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: p2.Id})
+	requireOK(t, err)
+	_, err = ts.client.LikePost(bob.ctx, &api.LikePostReq{PostId: p2.Id})
+	requireOK(t, err)
+	_, err = ts.client.LikePost(bob.ctx, &api.LikePostReq{PostId: p3.Id})
+	requireOK(t, err)
+
+	ts.createComment(t, bob, p1.Id, 0, "C1")
+	ts.createComment(t, bob, p1.Id, 0, "C2")
+	ts.createComment(t, alice, p3.Id, 0, "C3")
+
+	cases := []struct {
+		name     string
+		req      *api.GetPostsReq
+		expected []int64
+	}{
+		{"Oldest", &api.GetPostsReq{Sort: api.PostSort_POST_SORT_OLDEST}, []int64{p1.Id, p2.Id, p3.Id}},
+		{"Newest", &api.GetPostsReq{Sort: api.PostSort_POST_SORT_NEWEST}, []int64{p3.Id, p2.Id, p1.Id}},
+		{"TopLiked", &api.GetPostsReq{Sort: api.PostSort_POST_SORT_TOP_LIKED}, []int64{p2.Id, p3.Id, p1.Id}},
+		{"Hot", &api.GetPostsReq{Sort: api.PostSort_POST_SORT_HOT}, []int64{p2.Id, p3.Id, p1.Id}},
+		{"MostCommented", &api.GetPostsReq{Sort: api.PostSort_POST_SORT_MOST_COMMENTED}, []int64{p1.Id, p3.Id, p2.Id}},
+		{"Author", &api.GetPostsReq{AuthorId: alice.id}, []int64{p1.Id, p3.Id}},
+		{"AuthorTopLiked", &api.GetPostsReq{AuthorId: alice.id, Sort: api.PostSort_POST_SORT_TOP_LIKED}, []int64{p3.Id, p1.Id}},
+		{"CreatedAfter", &api.GetPostsReq{CreatedAfter: p2.CreatedAt}, []int64{p2.Id, p3.Id}},
+		{"CreatedBefore", &api.GetPostsReq{CreatedBefore: p3.CreatedAt}, []int64{p1.Id, p2.Id}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.req.Limit = 10
+			rsp, err := ts.client.GetPosts(context.Background(), c.req)
+			requireOK(t, err)
+			if !slices.Equal(postIDs(rsp.Posts), c.expected) {
+				t.Fatalf("Expected %v, got %v", c.expected, postIDs(rsp.Posts))
+			}
+		})
+	}
+}
+
+func TestSearchPosts(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	gophers := ts.createPost(t, alice, "Gophers everywhere")
+	ts.createPost(t, bob, "Rust and gophers")
+	ts.createPost(t, bob, "Nothing related")
+
+	rsp, err := ts.client.SearchPosts(context.Background(), &api.SearchPostsReq{Query: "gophers", Limit: 10})
+	requireOK(t, err)
+	if len(rsp.Results) != 2 {
+		t.Fatalf("Expected 2 results, got %v", rsp.Results)
+	}
+
+	rsp, err = ts.client.SearchPosts(context.Background(), &api.SearchPostsReq{Query: "gophers", AuthorId: alice.id, Limit: 10})
+	requireOK(t, err)
+	if len(rsp.Results) != 1 || rsp.Results[0].Post.Id != gophers.Id {
+		t.Fatalf("Unexpected results: %v", rsp.Results)
+	}
+
+	_, err = ts.client.SearchPosts(context.Background(), &api.SearchPostsReq{Query: "", Limit: 10})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.SearchPosts(context.Background(), &api.SearchPostsReq{Query: "gophers", Limit: 0})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestSearchSnippetsAreEscaped(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+
+	body := `Gophers <script>alert("x")</script> & friends`
+	_, err := ts.client.CreatePost(alice.ctx, &api.CreatePostReq{Post: &api.PostBody{Title: "<b>Gophers</b>", Body: body}})
+	requireOK(t, err)
+	post := ts.createPost(t, alice, "Other")
+	ts.createComment(t, alice, post.Id, 0, body)
+
+	posts, err := ts.client.SearchPosts(context.Background(), &api.SearchPostsReq{Query: "gophers", Limit: 10})
+	requireOK(t, err)
+	if len(posts.Results) != 1 {
+		t.Fatalf("Expected 1 result, got %v", posts.Results)
+	}
+	if got := posts.Results[0].TitleSnippet; got != "&lt;b&gt;<mark>Gophers</mark>&lt;/b&gt;" {
+		t.Fatalf("Unexpected title snippet: %s", got)
+	}
+	want := "<mark>Gophers</mark> &lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; friends"
+	if got := posts.Results[0].BodySnippet; got != want {
+		t.Fatalf("Unexpected body snippet: %s", got)
+	}
+
+	comments, err := ts.client.SearchComments(context.Background(), &api.SearchCommentsReq{Query: "gophers", Limit: 10})
+	requireOK(t, err)
+	if len(comments.Results) != 1 || comments.Results[0].Snippet != want {
+		t.Fatalf("Unexpected comment results: %v", comments.Results)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	api "go_1C/api"
+)
+
+func requireReactions(t *testing.T, reactions *api.Reactions, counts map[string]int64, mine []string) {
+	t.Helper()
+
+	if !maps.Equal(reactions.GetCounts(), counts) || !slices.Equal(reactions.GetMine(), mine) {
+		t.Fatalf("Expected counts %v and mine %v, got %v", counts, mine, reactions)
+	}
+}
+
+func TestPostReactions(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	post := ts.createPost(t, alice, "Post")
+
+	rsp, err := ts.client.React(bob.ctx, &api.ReactReq{PostId: post.Id, Reaction: "laugh"})
+	requireOK(t, err)
+	requireReactions(t, rsp.Reactions, map[string]int64{"laugh": 1}, []string{"laugh"})
+
+	// reactions are listed in the configured order
+	rsp, err = ts.client.React(bob.ctx, &api.ReactReq{PostId: post.Id, Reaction: "love"})
+	requireOK(t, err)
+	requireReactions(t, rsp.Reactions, map[string]int64{"love": 1, "laugh": 1}, []string{"love", "laugh"})
+
+	// like reaction is the like of the post
+	_, err = ts.client.React(alice.ctx, &api.ReactReq{PostId: post.Id, Reaction: "like"})
+	requireOK(t, err)
+	_, err = ts.client.LikePost(alice.ctx, &api.LikePostReq{PostId: post.Id})
+	requireCode(t, err, codes.AlreadyExists)
+	_, err = ts.client.React(alice.ctx, &api.ReactReq{PostId: post.Id, Reaction: "love"})
+	requireOK(t, err)
+
+	_, err = ts.client.React(bob.ctx, &api.ReactReq{PostId: post.Id, Reaction: "love"})
+	requireCode(t, err, codes.AlreadyExists)
+
+	posts, err := ts.client.GetPosts(alice.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if len(posts.Posts) != 1 || posts.Posts[0].Likes != 1 || !posts.Posts[0].IsLiked {
+		t.Fatalf("Unexpected posts %v", posts.Posts)
+	}
+	requireReactions(t, posts.Posts[0].Reactions, map[string]int64{"like": 1, "love": 2, "laugh": 1}, []string{"like", "love"})
+
+	posts, err = ts.client.GetPosts(bob.ctx, &api.GetPostsReq{Limit: 10, Sort: api.PostSort_POST_SORT_NEWEST})
+	requireOK(t, err)
+	requireReactions(t, posts.Posts[0].Reactions, map[string]int64{"like": 1, "love": 2, "laugh": 1}, []string{"love", "laugh"})
+
+	unreact_rsp, err := ts.client.Unreact(bob.ctx, &api.UnreactReq{PostId: post.Id, Reaction: "laugh"})
+	requireOK(t, err)
+	requireReactions(t, unreact_rsp.Reactions, map[string]int64{"like": 1, "love": 2}, []string{"love"})
+
+	_, err = ts.client.Unreact(bob.ctx, &api.UnreactReq{PostId: post.Id, Reaction: "laugh"})
+	requireCode(t, err, codes.AlreadyExists)
+
+	unreact_rsp, err = ts.client.Unreact(alice.ctx, &api.UnreactReq{PostId: post.Id, Reaction: "like"})
+	requireOK(t, err)
+	requireReactions(t, unreact_rsp.Reactions, map[string]int64{"love": 2}, []string{"love"})
+}
+
+func TestCommentReactions(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	_, err := ts.client.React(alice.ctx, &api.ReactReq{CommentId: comment.Id, Reaction: "sad"})
+	requireOK(t, err)
+	_, err = ts.client.React(alice.ctx, &api.ReactReq{CommentId: comment.Id, Reaction: "like"})
+	requireOK(t, err)
+
+	comments, err := ts.client.GetComments(alice.ctx, &api.GetCommentsReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	if len(comments.Comments) != 1 || comments.Comments[0].Likes != 1 {
+		t.Fatalf("Unexpected comments %v", comments.Comments)
+	}
+	requireReactions(t, comments.Comments[0].Reactions, map[string]int64{"like": 1, "sad": 1}, []string{"like", "sad"})
+
+	// reactions of the post and of the comment are apart
+	posts, err := ts.client.GetPosts(alice.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	requireReactions(t, posts.Posts[0].Reactions, map[string]int64{}, nil)
+}
+
+func TestReactionErrors(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	_, err := ts.client.React(alice.ctx, &api.ReactReq{PostId: post.Id, Reaction: "wow"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.React(alice.ctx, &api.ReactReq{Reaction: "love"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.React(alice.ctx, &api.ReactReq{PostId: post.Id, CommentId: comment.Id, Reaction: "love"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.React(alice.ctx, &api.ReactReq{PostId: 1000, Reaction: "love"})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.Unreact(alice.ctx, &api.UnreactReq{CommentId: 1000, Reaction: "love"})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.React(withToken(""), &api.ReactReq{PostId: post.Id, Reaction: "love"})
+	requireCode(t, err, codes.Unauthenticated)
+}
+
+func TestConfiguredReactions(t *testing.T) {
+	t.Setenv("REACTIONS", "wow,like")
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+
+	_, err := ts.client.React(alice.ctx, &api.ReactReq{PostId: post.Id, Reaction: "love"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	var rsp api.ReactRsp
+	code := ts.rest(t, http.MethodPost, "/react", alice.tokens.AccessToken, `{"post_id": 1, "reaction": "wow"}`, &rsp)
+	if code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", code)
+	}
+	requireReactions(t, rsp.Reactions, map[string]int64{"wow": 1}, []string{"wow"})
+
+	code = ts.rest(t, http.MethodDelete, "/unreact?post_id=1&reaction=wow", alice.tokens.AccessToken, "", nil)
+	if code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", code)
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	api "go_1C/api"
+	"go_1C/auth"
+)
+
+// testServer is the service on memory storage served over bufconn, with
+// the REST gateway in front of it, as main does over TCP.
+type testServer struct {
+	client  api.ServiceClient
+	gateway *httptest.Server
+}
+
+// newTestServer starts the server, options may replace stores of the
+// service before it starts serving.
+func newTestServer(t *testing.T, options ...func(s *Service)) *testServer {
+	t.Helper()
+
+	s := newMemoryService()
+	s.Logger = zap.NewNop()
+	s.LikesLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{Name: "likes_latency"},
+		[]string{"likes_latency"},
+	)
+	s.Tokens = auth.NewTokenManager([]byte("test-secret"), access_token_ttl)
+	for _, option := range options {
+		option(s)
+	}
+
+	lis := bufconn.Listen(1 << 20)
+	grpcServer := newGRPCServer(s)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	mux, err := newGatewayMux(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("Failed to register gateway: %v", err)
+	}
+	gateway := httptest.NewServer(mux)
+	t.Cleanup(gateway.Close)
+
+	return &testServer{client: api.NewServiceClient(conn), gateway: gateway}
+}
+
+// testUser is a registered user with context carrying its access token.
+type testUser struct {
+	id     int64
+	ctx    context.Context
+	tokens *api.Tokens
+}
+
+func (ts *testServer) register(t *testing.T, login string) *testUser {
+	t.Helper()
+
+	rsp, err := ts.client.Register(context.Background(), &api.RegisterReq{
+		Login:    login,
+		Password: "password-" + login,
+		Name:     "Name " + login,
+	})
+	if err != nil {
+		t.Fatalf("Register(%s): %v", login, err)
+	}
+
+	return &testUser{
+		id:     rsp.User.Id,
+		ctx:    withToken(rsp.Tokens.AccessToken),
+		tokens: rsp.Tokens,
+	}
+}
+
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func (ts *testServer) createPost(t *testing.T, user *testUser, title string) *api.Post {
+	t.Helper()
+
+	rsp, err := ts.client.CreatePost(user.ctx, &api.CreatePostReq{Post: &api.PostBody{Title: title, Body: "Body of " + title}})
+	if err != nil {
+		t.Fatalf("CreatePost(%s): %v", title, err)
+	}
+	return rsp.Post
+}
+
+func (ts *testServer) createComment(t *testing.T, user *testUser, post_id int64, parent_id int64, body string) *api.Comment {
+	t.Helper()
+
+	rsp, err := ts.client.CreateComment(user.ctx, &api.CreateCommentReq{PostId: post_id, ParentCommentId: parent_id, Body: body})
+	if err != nil {
+		t.Fatalf("CreateComment(%s): %v", body, err)
+	}
+	return rsp.Comment
+}
+
+// rest sends request to the gateway and decodes response into rsp if it is set.
+// It returns HTTP status code.
+func (ts *testServer) rest(t *testing.T, method string, path string, token string, body string, rsp proto.Message) int {
+	t.Helper()
+
+	req, err := http.NewRequest(method, ts.gateway.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	http_rsp, err := ts.gateway.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer http_rsp.Body.Close()
+
+	data, err := io.ReadAll(http_rsp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp != nil && http_rsp.StatusCode == http.StatusOK {
+		if err := protojson.Unmarshal(data, rsp); err != nil {
+			t.Fatalf("%s %s: decode %s: %v", method, path, data, err)
+		}
+	}
+	return http_rsp.StatusCode
+}
+
+func requireCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+
+	if status.Code(err) != code {
+		t.Fatalf("Expected %s, got %v", code, err)
+	}
+}
+
+func requireOK(t *testing.T, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func postIDs(posts []*api.Post) []int64 {
+	ids := make([]int64, len(posts))
+	for i, post := range posts {
+		ids[i] = post.Id
+	}
+	return ids
+}
+
+func commentIDs(comments []*api.Comment) []int64 {
+	ids := make([]int64, len(comments))
+	for i, comment := range comments {
+		ids[i] = comment.Id
+	}
+	return ids
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	api "go_1C/api"
+)
+
+func TestProfile(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+
+	user, err := ts.client.GetUser(context.Background(), &api.GetUserReq{Id: alice.id})
+	requireOK(t, err)
+	if user.User.Name != "Name alice" || user.User.Bio != "" {
+		t.Fatalf("Unexpected user: %v", user.User)
+	}
+
+	_, err = ts.client.GetUser(context.Background(), &api.GetUserReq{Id: 1000})
+	requireCode(t, err, codes.NotFound)
+
+	updated, err := ts.client.UpdateProfile(alice.ctx, &api.UpdateProfileReq{
+		Bio:       proto.String("Gopher"),
+		AvatarUrl: proto.String("https://example.com/alice.png"),
+	})
+	requireOK(t, err)
+	if updated.User.Name != "Name alice" || updated.User.Bio != "Gopher" || updated.User.AvatarUrl != "https://example.com/alice.png" {
+		t.Fatalf("Unexpected profile: %v", updated.User)
+	}
+
+	// fields that are not set are kept
+	updated, err = ts.client.UpdateProfile(alice.ctx, &api.UpdateProfileReq{Name: proto.String("Alice")})
+	requireOK(t, err)
+	if updated.User.Name != "Alice" || updated.User.Bio != "Gopher" {
+		t.Fatalf("Unexpected profile: %v", updated.User)
+	}
+
+	_, err = ts.client.UpdateProfile(alice.ctx, &api.UpdateProfileReq{Name: proto.String("")})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.UpdateProfile(alice.ctx, &api.UpdateProfileReq{AvatarUrl: proto.String("ftp://example.com/a.png")})
+	requireCode(t, err, codes.InvalidArgument)
+
+	// new name is shown on posts, cached main page included
+	ts.createPost(t, alice, "Post")
+	_, err = ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+
+	_, err = ts.client.UpdateProfile(alice.ctx, &api.UpdateProfileReq{Name: proto.String("Alice Gopher")})
+	requireOK(t, err)
+
+	posts, err := ts.client.GetPosts(context.Background(), &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if posts.Posts[0].Author.Name != "Alice Gopher" {
+		t.Fatalf("Unexpected author: %v", posts.Posts[0].Author)
+	}
+}
+
+func TestListUserPostsAndComments(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+
+	p1 := ts.createPost(t, alice, "P1")
+	ts.createPost(t, bob, "P2")
+	p3 := ts.createPost(t, alice, "P3")
+
+	c1 := ts.createComment(t, bob, p1.Id, 0, "C1")
+	ts.createComment(t, alice, p1.Id, 0, "C2")
+	c3 := ts.createComment(t, bob, p3.Id, 0, "C3")
+
+	posts, err := ts.client.ListUserPosts(context.Background(), &api.ListUserPostsReq{AuthorId: alice.id, Limit: 10})
+	requireOK(t, err)
+	if !slices.Equal(postIDs(posts.Posts), []int64{p3.Id, p1.Id}) {
+		t.Fatalf("Unexpected posts: %v", postIDs(posts.Posts))
+	}
+
+	posts, err = ts.client.ListUserPosts(context.Background(), &api.ListUserPostsReq{AuthorId: bob.id, Offset: 1, Limit: 10})
+	requireOK(t, err)
+	if len(posts.Posts) != 0 {
+		t.Fatalf("Expected no posts, got %v", postIDs(posts.Posts))
+	}
+
+	_, err = ts.client.ListUserPosts(context.Background(), &api.ListUserPostsReq{AuthorId: alice.id, Limit: 0})
+	requireCode(t, err, codes.InvalidArgument)
+
+	comments, err := ts.client.ListUserComments(context.Background(), &api.ListUserCommentsReq{AuthorId: bob.id, Limit: 10})
+	requireOK(t, err)
+	if !slices.Equal(commentIDs(comments.Comments), []int64{c3.Id, c1.Id}) {
+		t.Fatalf("Unexpected comments: %v", commentIDs(comments.Comments))
+	}
+
+	_, err = ts.client.ListUserComments(context.Background(), &api.ListUserCommentsReq{AuthorId: bob.id, Offset: -1, Limit: 10})
+	requireCode(t, err, codes.InvalidArgument)
+}
+
+func TestFollowAndFeed(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	carol := ts.register(t, "carol")
+
+	_, err := ts.client.Follow(carol.ctx, &api.FollowReq{FolloweeId: alice.id})
+	requireOK(t, err)
+	_, err = ts.client.Follow(carol.ctx, &api.FollowReq{FolloweeId: bob.id})
+	requireOK(t, err)
+
+	_, err = ts.client.Follow(carol.ctx, &api.FollowReq{FolloweeId: alice.id})
+	requireCode(t, err, codes.AlreadyExists)
+
+	_, err = ts.client.Follow(carol.ctx, &api.FollowReq{FolloweeId: carol.id})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Follow(carol.ctx, &api.FollowReq{FolloweeId: 1000})
+	requireCode(t, err, codes.NotFound)
+
+	followers, err := ts.client.ListFollowers(context.Background(), &api.ListFollowersReq{Id: alice.id, Limit: 10})
+	requireOK(t, err)
+	if len(followers.Users) != 1 || followers.Users[0].Id != carol.id {
+		t.Fatalf("Unexpected followers: %v", followers.Users)
+	}
+
+	following, err := ts.client.ListFollowing(context.Background(), &api.ListFollowingReq{Id: carol.id, Limit: 10})
+	requireOK(t, err)
+	if len(following.Users) != 2 {
+		t.Fatalf("Unexpected following: %v", following.Users)
+	}
+
+	_, err = ts.client.ListFollowing(context.Background(), &api.ListFollowingReq{Id: carol.id, Limit: 0})
+	requireCode(t, err, codes.InvalidArgument)
+
+	p1 := ts.createPost(t, alice, "P1")
+	p2 := ts.createPost(t, bob, "P2")
+	ts.createPost(t, carol, "Own post")
+	p3 := ts.createPost(t, alice, "P3")
+
+	var got []int64
+	req := &api.GetFeedReq{Limit: 2}
+	for {
+		rsp, err := ts.client.GetFeed(carol.ctx, req)
+		requireOK(t, err)
+		got = append(got, postIDs(rsp.Posts)...)
+		if rsp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = rsp.NextPageToken
+	}
+	if !slices.Equal(got, []int64{p3.Id, p2.Id, p1.Id}) {
+		t.Fatalf("Unexpected feed: %v", got)
+	}
+
+	_, err = ts.client.GetFeed(carol.ctx, &api.GetFeedReq{Limit: 0})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.GetFeed(carol.ctx, &api.GetFeedReq{Limit: 2, PageToken: "garbage"})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Unfollow(carol.ctx, &api.UnfollowReq{FolloweeId: alice.id})
+	requireOK(t, err)
+
+	_, err = ts.client.Unfollow(carol.ctx, &api.UnfollowReq{FolloweeId: alice.id})
+	requireCode(t, err, codes.NotFound)
+
+	feed, err := ts.client.GetFeed(carol.ctx, &api.GetFeedReq{Limit: 10})
+	requireOK(t, err)
+	if !slices.Equal(postIDs(feed.Posts), []int64{p2.Id}) {
+		t.Fatalf("Unexpected feed after unfollow: %v", postIDs(feed.Posts))
+	}
+}
+
+func TestFeedSkipsDeletedPosts(t *testing.T) {
+	var s *Service
+	ts := newTestServer(t, func(service *Service) { s = service })
+	ctx := context.Background()
+	alice := ts.register(t, "alice")
+	carol := ts.register(t, "carol")
+
+	_, err := ts.client.Follow(carol.ctx, &api.FollowReq{FolloweeId: alice.id})
+	requireOK(t, err)
+
+	p1 := ts.createPost(t, alice, "P1")
+	p2 := ts.createPost(t, alice, "P2")
+	p3 := ts.createPost(t, alice, "P3")
+	p4 := ts.createPost(t, alice, "P4")
+	p5 := ts.createPost(t, alice, "P5")
+
+	for _, post := range []*api.Post{p4, p2} {
+		_, err = ts.client.DeletePost(alice.ctx, &api.DeletePostReq{PostId: post.Id})
+		requireOK(t, err)
+	}
+	if err := s.processOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	readFeed := func() []int64 {
+		t.Helper()
+		var got []int64
+		req := &api.GetFeedReq{Limit: 2}
+		for {
+			rsp, err := ts.client.GetFeed(carol.ctx, req)
+			requireOK(t, err)
+			got = append(got, postIDs(rsp.Posts)...)
+			if rsp.NextPageToken == "" {
+				return got
+			}
+			req.PageToken = rsp.NextPageToken
+		}
+	}
+	if got := readFeed(); !slices.Equal(got, []int64{p5.Id, p3.Id, p1.Id}) {
+		t.Fatalf("Unexpected feed: %v", got)
+	}
+
+	_, err = ts.client.RestorePost(alice.ctx, &api.RestorePostReq{PostId: p4.Id})
+	requireOK(t, err)
+	if err := s.processOutbox(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := readFeed(); !slices.Equal(got, []int64{p5.Id, p4.Id, p3.Id, p1.Id}) {
+		t.Fatalf("Unexpected feed after restore: %v", got)
+	}
+}
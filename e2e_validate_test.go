@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "go_1C/api"
+)
+
+// violatedFields returns fields listed in BadRequest details of err.
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+	requireCode(t, err, codes.InvalidArgument)
+
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if bad_request, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range bad_request.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidation(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+
+	cases := map[string]struct {
+		call   func() error
+		fields []string
+	}{
+		"EmptyTitle": {
+			call: func() error {
+				_, err := ts.client.CreatePost(alice.ctx, &api.CreatePostReq{Post: &api.PostBody{Title: "", Body: "B"}})
+				return err
+			},
+			fields: []string{"post.title"},
+		},
+		"MissingPost": {
+			call: func() error {
+				_, err := ts.client.EditPost(alice.ctx, &api.EditPostReq{PostId: post.Id})
+				return err
+			},
+			fields: []string{"post"},
+		},
+		"SeveralFields": {
+			call: func() error {
+				_, err := ts.client.EditPost(alice.ctx, &api.EditPostReq{PostId: 0, Post: &api.PostBody{Title: "T", Body: ""}})
+				return err
+			},
+			fields: []string{"post_id", "post.body"},
+		},
+		"ZeroLimit": {
+			call: func() error {
+				_, err := ts.client.GetComments(context.Background(), &api.GetCommentsReq{PostId: post.Id, Limit: 0})
+				return err
+			},
+			fields: []string{"limit"},
+		},
+		"TooLargeLimit": {
+			call: func() error {
+				_, err := ts.client.GetComments(context.Background(), &api.GetCommentsReq{PostId: post.Id, Limit: 101})
+				return err
+			},
+			fields: []string{"limit"},
+		},
+		"TooDeepThread": {
+			call: func() error {
+				_, err := ts.client.GetCommentThread(context.Background(), &api.GetCommentThreadReq{PostId: post.Id, Depth: 11, Limit: 10})
+				return err
+			},
+			fields: []string{"depth"},
+		},
+		"EmptyQuery": {
+			call: func() error {
+				_, err := ts.client.SearchPosts(context.Background(), &api.SearchPostsReq{Limit: 10})
+				return err
+			},
+			fields: []string{"query"},
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			fields := violatedFields(t, c.call())
+			slices.Sort(fields)
+			slices.Sort(c.fields)
+			if !slices.Equal(fields, c.fields) {
+				t.Fatalf("Expected violations of %v, got %v", c.fields, fields)
+			}
+		})
+	}
+
+	// optional fields are checked only if they are set
+	_, err := ts.client.UpdateProfile(alice.ctx, &api.UpdateProfileReq{})
+	requireOK(t, err)
+
+	empty := ""
+	fields := violatedFields(t, func() error {
+		_, err := ts.client.UpdateProfile(alice.ctx, &api.UpdateProfileReq{Name: &empty})
+		return err
+	}())
+	if !slices.Equal(fields, []string{"name"}) {
+		t.Fatalf("Expected violations of [name], got %v", fields)
+	}
+}
+
+func TestGatewayValidation(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+
+	code := ts.rest(t, http.MethodPost, "/create-post", alice.tokens.AccessToken, `{"post": {"title": "", "body": "B"}}`, nil)
+	if code != http.StatusBadRequest {
+		t.Fatalf("POST /create-post with empty title: %d", code)
+	}
+
+	code = ts.rest(t, http.MethodGet, "/get-posts?limit=101", "", "", nil)
+	if code != http.StatusBadRequest {
+		t.Fatalf("GET /get-posts with too large limit: %d", code)
+	}
+}
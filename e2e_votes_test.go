@@ -0,0 +1,134 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	api "go_1C/api"
+)
+
+func requireVotes(t *testing.T, votes *api.Votes, score int64, up int64, down int64, mine api.Vote) {
+	t.Helper()
+
+	if votes.GetScore() != score || votes.GetUp() != up || votes.GetDown() != down || votes.GetMine() != mine {
+		t.Fatalf("Expected score %d, up %d, down %d, mine %s, got %v", score, up, down, mine, votes)
+	}
+}
+
+func TestPostVotes(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	carol := ts.register(t, "carol")
+	post := ts.createPost(t, alice, "Post")
+
+	rsp, err := ts.client.Vote(bob.ctx, &api.VoteReq{PostId: post.Id, Vote: api.Vote_VOTE_DOWN})
+	requireOK(t, err)
+	requireVotes(t, rsp.Votes, -1, 0, 1, api.Vote_VOTE_DOWN)
+
+	// the same vote again is not an error
+	rsp, err = ts.client.Vote(bob.ctx, &api.VoteReq{PostId: post.Id, Vote: api.Vote_VOTE_DOWN})
+	requireOK(t, err)
+	requireVotes(t, rsp.Votes, -1, 0, 1, api.Vote_VOTE_DOWN)
+
+	rsp, err = ts.client.Vote(bob.ctx, &api.VoteReq{PostId: post.Id, Vote: api.Vote_VOTE_UP})
+	requireOK(t, err)
+	requireVotes(t, rsp.Votes, 1, 1, 0, api.Vote_VOTE_UP)
+
+	_, err = ts.client.Vote(carol.ctx, &api.VoteReq{PostId: post.Id, Vote: api.Vote_VOTE_UP})
+	requireOK(t, err)
+
+	// votes are apart from likes
+	posts, err := ts.client.GetPosts(bob.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	if posts.Posts[0].Likes != 0 {
+		t.Fatalf("Votes changed likes: %v", posts.Posts[0])
+	}
+	requireVotes(t, posts.Posts[0].Votes, 2, 2, 0, api.Vote_VOTE_UP)
+
+	posts, err = ts.client.GetPosts(alice.ctx, &api.GetPostsReq{Limit: 10, Sort: api.PostSort_POST_SORT_NEWEST})
+	requireOK(t, err)
+	requireVotes(t, posts.Posts[0].Votes, 2, 2, 0, api.Vote_VOTE_NONE)
+
+	rsp, err = ts.client.Vote(bob.ctx, &api.VoteReq{PostId: post.Id, Vote: api.Vote_VOTE_NONE})
+	requireOK(t, err)
+	requireVotes(t, rsp.Votes, 1, 1, 0, api.Vote_VOTE_NONE)
+
+	rsp, err = ts.client.Vote(bob.ctx, &api.VoteReq{PostId: post.Id, Vote: api.Vote_VOTE_NONE})
+	requireOK(t, err)
+	requireVotes(t, rsp.Votes, 1, 1, 0, api.Vote_VOTE_NONE)
+}
+
+func TestCommentVotes(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+	comment := ts.createComment(t, alice, post.Id, 0, "Comment")
+
+	_, err := ts.client.Vote(alice.ctx, &api.VoteReq{CommentId: comment.Id, Vote: api.Vote_VOTE_DOWN})
+	requireOK(t, err)
+
+	comments, err := ts.client.GetComments(alice.ctx, &api.GetCommentsReq{PostId: post.Id, Limit: 10})
+	requireOK(t, err)
+	requireVotes(t, comments.Comments[0].Votes, -1, 0, 1, api.Vote_VOTE_DOWN)
+
+	posts, err := ts.client.GetPosts(alice.ctx, &api.GetPostsReq{Limit: 10})
+	requireOK(t, err)
+	requireVotes(t, posts.Posts[0].Votes, 0, 0, 0, api.Vote_VOTE_NONE)
+}
+
+func TestVoteErrors(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	post := ts.createPost(t, alice, "Post")
+
+	_, err := ts.client.Vote(alice.ctx, &api.VoteReq{Vote: api.Vote_VOTE_UP})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Vote(alice.ctx, &api.VoteReq{PostId: post.Id, CommentId: 1, Vote: api.Vote_VOTE_UP})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Vote(alice.ctx, &api.VoteReq{PostId: post.Id, Vote: api.Vote(10)})
+	requireCode(t, err, codes.InvalidArgument)
+
+	_, err = ts.client.Vote(alice.ctx, &api.VoteReq{PostId: 1000, Vote: api.Vote_VOTE_UP})
+	requireCode(t, err, codes.NotFound)
+
+	_, err = ts.client.Vote(alice.ctx, &api.VoteReq{CommentId: 1000, Vote: api.Vote_VOTE_UP})
+	requireCode(t, err, codes.NotFound)
+}
+
+func TestGetPostsByScore(t *testing.T) {
+	ts := newTestServer(t)
+	alice := ts.register(t, "alice")
+	bob := ts.register(t, "bob")
+	p1 := ts.createPost(t, alice, "First")
+	p2 := ts.createPost(t, alice, "Second")
+	p3 := ts.createPost(t, alice, "Third")
+
+	for _, vote := range []struct {
+		user    *testUser
+		post_id int64
+		vote    api.Vote
+	}{
+		{alice, p1.Id, api.Vote_VOTE_DOWN},
+		{bob, p1.Id, api.Vote_VOTE_DOWN},
+		{alice, p3.Id, api.Vote_VOTE_UP},
+		{bob, p3.Id, api.Vote_VOTE_UP},
+		{alice, p2.Id, api.Vote_VOTE_UP},
+		{bob, p2.Id, api.Vote_VOTE_NONE},
+	} {
+		_, err := ts.client.Vote(vote.user.ctx, &api.VoteReq{PostId: vote.post_id, Vote: vote.vote})
+		requireOK(t, err)
+	}
+	// likes do not change the score
+	_, err := ts.client.LikePost(bob.ctx, &api.LikePostReq{PostId: p1.Id})
+	requireOK(t, err)
+
+	rsp, err := ts.client.GetPosts(alice.ctx, &api.GetPostsReq{Limit: 10, Sort: api.PostSort_POST_SORT_TOP_SCORE})
+	requireOK(t, err)
+	if ids := postIDs(rsp.Posts); len(ids) != 3 || ids[0] != p3.Id || ids[1] != p2.Id || ids[2] != p1.Id {
+		t.Fatalf("Expected posts %d, %d, %d, got %v", p3.Id, p2.Id, p1.Id, ids)
+	}
+}
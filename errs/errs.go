@@ -0,0 +1,137 @@
+// Package errs declares errors returned by handlers of the service. Every
+// error has a kind which is mapped to gRPC status code, the gateway maps the
+// code to HTTP status: NotFound is 404, PermissionDenied is 403, Conflict is
+// 409, InvalidArgument is 400, Unauthenticated is 401, Unavailable is 503 and
+// Internal is 500.
+//
+// Message of the error is shown to clients as is, so it must not contain
+// details of the failure. Cause of Internal and Unavailable errors is only
+// logged by UnaryServerInterceptor.
+package errs
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"net"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Domain of errdetails.ErrorInfo attached to every error.
+const domain = "go_1C"
+
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindInvalidArgument
+	KindNotFound
+	KindConflict
+	KindPermissionDenied
+	KindUnauthenticated
+	KindUnavailable
+)
+
+var kinds = map[Kind]struct {
+	code   codes.Code
+	reason string
+}{
+	KindInternal:         {codes.Internal, "INTERNAL"},
+	KindInvalidArgument:  {codes.InvalidArgument, "INVALID_ARGUMENT"},
+	KindNotFound:         {codes.NotFound, "NOT_FOUND"},
+	KindConflict:         {codes.AlreadyExists, "CONFLICT"},
+	KindPermissionDenied: {codes.PermissionDenied, "PERMISSION_DENIED"},
+	KindUnauthenticated:  {codes.Unauthenticated, "UNAUTHENTICATED"},
+	KindUnavailable:      {codes.Unavailable, "UNAVAILABLE"},
+}
+
+// Code returns gRPC status code of the kind.
+func (k Kind) Code() codes.Code {
+	return kinds[k].code
+}
+
+func (k Kind) String() string {
+	return kinds[k].reason
+}
+
+type Error struct {
+	Kind    Kind
+	Message string
+	// Cause is the failure behind Internal and Unavailable errors.
+	Cause error
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return e.Message + " " + e.Cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// GRPCStatus is used by status.FromError, so *Error can be returned from
+// handlers as is. Status carries errdetails.ErrorInfo with the kind as reason.
+func (e *Error) GRPCStatus() *status.Status {
+	code := e.Kind.Code()
+	message := e.Message
+	// client has gone, the failure is not ours
+	if errors.Is(e.Cause, context.Canceled) {
+		code, message = codes.Canceled, "Request is cancelled!"
+	} else if errors.Is(e.Cause, context.DeadlineExceeded) {
+		code, message = codes.DeadlineExceeded, "Request timed out!"
+	}
+
+	st := status.New(code, message)
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: e.Kind.String(), Domain: domain}); err == nil {
+		st = detailed
+	}
+	return st
+}
+
+func InvalidArgument(message string) *Error {
+	return &Error{Kind: KindInvalidArgument, Message: message}
+}
+
+func NotFound(message string) *Error {
+	return &Error{Kind: KindNotFound, Message: message}
+}
+
+// Conflict means the request contradicts current state, e.g. the post is
+// already liked. It is reported as AlreadyExists.
+func Conflict(message string) *Error {
+	return &Error{Kind: KindConflict, Message: message}
+}
+
+func PermissionDenied(message string) *Error {
+	return &Error{Kind: KindPermissionDenied, Message: message}
+}
+
+func Unauthenticated(message string) *Error {
+	return &Error{Kind: KindUnauthenticated, Message: message}
+}
+
+// Internal wraps unexpected failure of storage or another dependency. If the
+// dependency is not reachable, the error is Unavailable, so clients may retry.
+func Internal(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	if unreachable(err) {
+		return &Error{Kind: KindUnavailable, Message: "Service is temporarily unavailable!", Cause: err}
+	}
+	return &Error{Kind: KindInternal, Message: "Internal error!", Cause: err}
+}
+
+// unreachable reports whether err is a network failure or a broken
+// connection of database/sql.
+func unreachable(err error) bool {
+	var net_err net.Error
+	return errors.As(err, &net_err) || errors.Is(err, driver.ErrBadConn)
+}
@@ -0,0 +1,39 @@
+package errs
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor logs causes of Internal and Unavailable errors and
+// hides them from clients. Errors which are neither *Error nor gRPC status
+// are treated as Internal, so raw storage errors never reach clients.
+func UnaryServerInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		rsp, err := handler(ctx, req)
+		if err == nil {
+			return rsp, nil
+		}
+
+		var e *Error
+		if !errors.As(err, &e) {
+			if _, ok := status.FromError(err); ok {
+				return rsp, err
+			}
+			e = Internal(err)
+		}
+
+		if e.Cause != nil && ctx.Err() == nil {
+			logger.Error("Request failed",
+				zap.String("method", info.FullMethod),
+				zap.Stringer("kind", e.Kind),
+				zap.Error(e.Cause),
+			)
+		}
+		return rsp, e.GRPCStatus().Err()
+	}
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"go.uber.org/zap"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/models"
+	"go_1C/pagination"
+	"go_1C/storage"
+)
+
+func (s *Service) GetFeed(ctx context.Context, req *api.GetFeedReq) (*api.GetFeedRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.GetFeedRsp{}, err
+	}
+	log.Println("User:", user_id, "callded GetFeed")
+
+	var cursor *pagination.Cursor
+	if req.PageToken != "" {
+		c, err := pagination.Decode(req.PageToken, pagination.Newest)
+		if err != nil {
+			return &api.GetFeedRsp{}, errs.InvalidArgument(err.Error())
+		}
+		cursor = &c
+	}
+
+	s.Logger.Info("Feed: start read feed;", zap.Int64("user_id", user_id))
+	posts, err := s.Feed.Feed(ctx, uint(user_id), cursor, req.Limit)
+	s.Logger.Info("Feed: ended read feed;", zap.Int64("user_id", user_id))
+	if err != nil {
+		return &api.GetFeedRsp{}, errs.Internal(err)
+	}
+
+	posts_rsp, err := s.postsToAPI(ctx, posts, user_id)
+	if err != nil {
+		return &api.GetFeedRsp{}, errs.Internal(err)
+	}
+
+	var next_page_token string
+	if int64(len(posts)) == req.Limit {
+		last := posts[len(posts)-1]
+		next_page_token = pagination.Cursor{CreatedAt: last.CreatedAt, ID: int64(last.ID), Order: pagination.Newest}.Encode()
+	}
+
+	return &api.GetFeedRsp{Posts: posts_rsp, NextPageToken: next_page_token}, nil
+}
+
+func (s *Service) Follow(ctx context.Context, req *api.FollowReq) (*api.FollowRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.FollowRsp{}, err
+	}
+	log.Println("User:", user_id, "callded Follow")
+
+	if req.FolloweeId == user_id {
+		return &api.FollowRsp{}, errs.InvalidArgument("You can not follow yourself!")
+	}
+
+	_, err = s.Users.Get(ctx, uint(req.FolloweeId))
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.FollowRsp{}, errs.NotFound("User not found!")
+	} else if err != nil {
+		return &api.FollowRsp{}, errs.Internal(err)
+	}
+
+	err = s.Users.Follow(ctx, uint(user_id), uint(req.FolloweeId))
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		return &api.FollowRsp{}, errs.Conflict("You already follow this user!")
+	} else if err != nil {
+		return &api.FollowRsp{}, errs.Internal(err)
+	}
+
+	// feed is rebuilt with posts of the new followee on the next read
+	if err := s.Feed.Reset(ctx, uint(user_id)); err != nil {
+		return &api.FollowRsp{}, errs.Internal(err)
+	}
+
+	return &api.FollowRsp{}, nil
+}
+
+func (s *Service) Unfollow(ctx context.Context, req *api.UnfollowReq) (*api.UnfollowRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.UnfollowRsp{}, err
+	}
+	log.Println("User:", user_id, "callded Unfollow")
+
+	err = s.Users.Unfollow(ctx, uint(user_id), uint(req.FolloweeId))
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.UnfollowRsp{}, errs.NotFound("You do not follow this user!")
+	} else if err != nil {
+		return &api.UnfollowRsp{}, errs.Internal(err)
+	}
+
+	if err := s.Feed.Reset(ctx, uint(user_id)); err != nil {
+		return &api.UnfollowRsp{}, errs.Internal(err)
+	}
+
+	return &api.UnfollowRsp{}, nil
+}
+
+func (s *Service) ListFollowers(ctx context.Context, req *api.ListFollowersReq) (*api.ListFollowersRsp, error) {
+	log.Println("User:", auth.UserID(ctx), "callded ListFollowers")
+
+	followers, err := s.Users.ListFollowers(ctx, uint(req.Id), req.Offset, req.Limit)
+	if err != nil {
+		return &api.ListFollowersRsp{}, errs.Internal(err)
+	}
+
+	return &api.ListFollowersRsp{Users: usersInfo(followers)}, nil
+}
+
+func (s *Service) ListFollowing(ctx context.Context, req *api.ListFollowingReq) (*api.ListFollowingRsp, error) {
+	log.Println("User:", auth.UserID(ctx), "callded ListFollowing")
+
+	following, err := s.Users.ListFollowing(ctx, uint(req.Id), req.Offset, req.Limit)
+	if err != nil {
+		return &api.ListFollowingRsp{}, errs.Internal(err)
+	}
+
+	return &api.ListFollowingRsp{Users: usersInfo(following)}, nil
+}
+
+func usersInfo(users []models.User) []*api.UserInfo {
+	users_info := make([]*api.UserInfo, len(users))
+	for i, user := range users {
+		users_info[i] = &api.UserInfo{Id: int64(user.ID), Name: user.Name}
+	}
+	return users_info
+}
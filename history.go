@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+func (s *Service) RestorePost(ctx context.Context, req *api.RestorePostReq) (*api.RestorePostRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.RestorePostRsp{}, err
+	}
+	log.Println("User:", user_id, "callded RestorePost")
+
+	post, err := s.Posts.GetUnscoped(ctx, uint(req.PostId))
+	if errors.Is(err, storage.ErrNotFound) || (err == nil && !post.DeletedAt.Valid) {
+		return &api.RestorePostRsp{}, errs.NotFound("Deleted post not found!")
+	} else if err != nil {
+		return &api.RestorePostRsp{}, errs.Internal(err)
+	}
+
+	if post.AuthorID != uint(user_id) {
+		return &api.RestorePostRsp{}, errs.PermissionDenied("You are not the author!")
+	}
+
+	if err := s.Posts.Restore(ctx, post.ID); err != nil {
+		return &api.RestorePostRsp{}, errs.Internal(err)
+	}
+
+	s.invalidatePostsCache(ctx)
+
+	post.DeletedAt = gorm.DeletedAt{}
+	posts_rsp, err := s.postsToAPI(ctx, []models.Post{*post}, user_id)
+	if err != nil {
+		return &api.RestorePostRsp{}, errs.Internal(err)
+	}
+
+	return &api.RestorePostRsp{Post: posts_rsp[0]}, nil
+}
+
+func (s *Service) GetPostHistory(ctx context.Context, req *api.GetPostHistoryReq) (*api.GetPostHistoryRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded GetPostHistory")
+
+	// history of deleted post is visible to its author only
+	post, err := s.Posts.GetUnscoped(ctx, uint(req.PostId))
+	if errors.Is(err, storage.ErrNotFound) || (err == nil && post.DeletedAt.Valid && post.AuthorID != uint(user_id)) {
+		return &api.GetPostHistoryRsp{}, errs.NotFound("Post not found!")
+	} else if err != nil {
+		return &api.GetPostHistoryRsp{}, errs.Internal(err)
+	}
+
+	revisions, err := s.Posts.Revisions(ctx, post.ID, req.Offset, req.Limit)
+	if err != nil {
+		return &api.GetPostHistoryRsp{}, errs.Internal(err)
+	}
+
+	revisions_rsp := make([]*api.PostRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		revisions_rsp = append(revisions_rsp, &api.PostRevision{
+			Id:        int64(revision.ID),
+			Post:      &api.PostBody{Title: revision.Title, Body: revision.Body},
+			CreatedAt: timestamppb.New(revision.CreatedAt),
+		})
+	}
+
+	return &api.GetPostHistoryRsp{Revisions: revisions_rsp}, nil
+}
+
+func (s *Service) RestoreComment(ctx context.Context, req *api.RestoreCommentReq) (*api.RestoreCommentRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.RestoreCommentRsp{}, err
+	}
+	log.Println("User:", user_id, "callded RestoreComment")
+
+	comment, err := s.Comments.GetUnscoped(ctx, uint(req.CommentId))
+	if errors.Is(err, storage.ErrNotFound) || (err == nil && !comment.DeletedAt.Valid) {
+		return &api.RestoreCommentRsp{}, errs.NotFound("Deleted comment not found!")
+	} else if err != nil {
+		return &api.RestoreCommentRsp{}, errs.Internal(err)
+	}
+
+	if comment.AuthorID != uint(user_id) {
+		return &api.RestoreCommentRsp{}, errs.PermissionDenied("You are not the author!")
+	}
+
+	if err := s.Comments.Restore(ctx, comment.ID); err != nil {
+		return &api.RestoreCommentRsp{}, errs.Internal(err)
+	}
+
+	s.invalidatePostsCache(ctx)
+
+	comment.DeletedAt = gorm.DeletedAt{}
+	comments_rsp, err := s.commentsToAPI(ctx, []models.Comment{*comment}, user_id)
+	if err != nil {
+		return &api.RestoreCommentRsp{}, errs.Internal(err)
+	}
+
+	return &api.RestoreCommentRsp{Comment: comments_rsp[0]}, nil
+}
+
+func (s *Service) GetCommentHistory(ctx context.Context, req *api.GetCommentHistoryReq) (*api.GetCommentHistoryRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded GetCommentHistory")
+
+	// history of deleted comment is visible to its author only
+	comment, err := s.Comments.GetUnscoped(ctx, uint(req.CommentId))
+	if errors.Is(err, storage.ErrNotFound) || (err == nil && comment.DeletedAt.Valid && comment.AuthorID != uint(user_id)) {
+		return &api.GetCommentHistoryRsp{}, errs.NotFound("Comment not found!")
+	} else if err != nil {
+		return &api.GetCommentHistoryRsp{}, errs.Internal(err)
+	}
+
+	revisions, err := s.Comments.Revisions(ctx, comment.ID, req.Offset, req.Limit)
+	if err != nil {
+		return &api.GetCommentHistoryRsp{}, errs.Internal(err)
+	}
+
+	revisions_rsp := make([]*api.CommentRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		revisions_rsp = append(revisions_rsp, &api.CommentRevision{
+			Id:        int64(revision.ID),
+			Body:      revision.Body,
+			CreatedAt: timestamppb.New(revision.CreatedAt),
+		})
+	}
+
+	return &api.GetCommentHistoryRsp{Revisions: revisions_rsp}, nil
+}
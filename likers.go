@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/storage"
+)
+
+func (s *Service) ListPostLikers(ctx context.Context, req *api.ListPostLikersReq) (*api.ListPostLikersRsp, error) {
+	log.Println("User:", auth.UserID(ctx), "callded ListPostLikers")
+
+	if _, err := s.Posts.Get(ctx, uint(req.PostId)); errors.Is(err, storage.ErrNotFound) {
+		return &api.ListPostLikersRsp{}, errs.NotFound("Post not found!")
+	} else if err != nil {
+		return &api.ListPostLikersRsp{}, errs.Internal(err)
+	}
+
+	liker_ids, err := s.Likes.PostLikers(ctx, uint(req.PostId), req.Offset, req.Limit)
+	if err != nil {
+		return &api.ListPostLikersRsp{}, errs.Internal(err)
+	}
+
+	likers, err := s.Users.FindByIDs(ctx, liker_ids)
+	if err != nil {
+		return &api.ListPostLikersRsp{}, errs.Internal(err)
+	}
+
+	return &api.ListPostLikersRsp{Users: usersInfo(likers)}, nil
+}
+
+func (s *Service) ListCommentLikers(ctx context.Context, req *api.ListCommentLikersReq) (*api.ListCommentLikersRsp, error) {
+	log.Println("User:", auth.UserID(ctx), "callded ListCommentLikers")
+
+	if _, err := s.Comments.Get(ctx, uint(req.CommentId)); errors.Is(err, storage.ErrNotFound) {
+		return &api.ListCommentLikersRsp{}, errs.NotFound("Comment not found!")
+	} else if err != nil {
+		return &api.ListCommentLikersRsp{}, errs.Internal(err)
+	}
+
+	liker_ids, err := s.Likes.CommentLikers(ctx, uint(req.CommentId), req.Offset, req.Limit)
+	if err != nil {
+		return &api.ListCommentLikersRsp{}, errs.Internal(err)
+	}
+
+	likers, err := s.Users.FindByIDs(ctx, liker_ids)
+	if err != nil {
+		return &api.ListCommentLikersRsp{}, errs.Internal(err)
+	}
+
+	return &api.ListCommentLikersRsp{Users: usersInfo(likers)}, nil
+}
+
+// fillLikedBy sets "liked by followee and others" summary of posts for the
+// viewer, likes must be filled already.
+func (s *Service) fillLikedBy(ctx context.Context, posts []*api.Post, user_id int64) error {
+	if user_id == 0 || len(posts) == 0 {
+		return nil
+	}
+
+	followee_ids, err := s.Users.FolloweeIDs(ctx, uint(user_id))
+	if err != nil || len(followee_ids) == 0 {
+		return err
+	}
+
+	post_ids := make([]uint, len(posts))
+	for i, post := range posts {
+		post_ids[i] = uint(post.Id)
+	}
+
+	liker_ids, err := s.Likes.LatestPostLikers(ctx, post_ids, followee_ids)
+	if err != nil {
+		return err
+	}
+
+	likers, err := s.Users.FindByIDs(ctx, liker_ids)
+	if err != nil {
+		return err
+	}
+	likers_info := make(map[uint]*api.UserInfo, len(likers))
+	for i, liker := range usersInfo(likers) {
+		likers_info[likers[i].ID] = liker
+	}
+
+	for i, post := range posts {
+		post.LikedBy = nil
+		if liker, ok := likers_info[liker_ids[i]]; ok {
+			post.LikedBy = &api.LikedBy{User: liker, Others: max(post.Likes-1, 0)}
+		}
+	}
+	return nil
+}
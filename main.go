@@ -3,15 +3,14 @@ package main
 import (
 	"context"
 	"embed"
-	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"io/fs"
 	"log"
 	"net"
 	"net/http"
 	"os"
-	"sort"
-	"sync"
 	"time"
 
 	"gorm.io/driver/postgres"
@@ -20,15 +19,24 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/cache"
+	"go_1C/errs"
 	"go_1C/models"
+	"go_1C/pagination"
+	"go_1C/storage"
+	"go_1C/storage/memory"
+	pgstorage "go_1C/storage/postgres"
+	redisstorage "go_1C/storage/redis"
+	"go_1C/validate"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
@@ -47,460 +55,611 @@ var rdb *redis.Client
 var rctx context.Context
 
 const cached_posts_limit int64 = 30
+const default_cached_posts_ttl = 5 * time.Second
+const access_token_ttl = 15 * time.Minute
+
+// Values of the --storage flag.
+const (
+	storage_postgres = "postgres"
+	storage_memory   = "memory"
+)
 
 type Service struct {
 	api.UnimplementedServiceServer
 	Logger       *zap.Logger
 	LikesLatency *prometheus.HistogramVec
+	Tokens       *auth.TokenManager
+	PostsCache   *cache.PostsCache
+	Posts        storage.PostRepository
+	Comments     storage.CommentRepository
+	Users        storage.UserRepository
+	Likes        storage.LikeStore
+	Reactions    storage.ReactionStore
+	// reactions allowed by React, see reactionTypes
+	ReactionTypes []string
+	Votes         storage.VoteStore
+	Sessions      storage.SessionStore
+	Feed          storage.FeedStore
+	Outbox        storage.Outbox
 }
 
-func (s *Service) GetPosts(
-	ctx context.Context, req *api.GetPostsReq,
-) (*api.GetPostsRsp, error) {
-	log.Println("User:", req.UserId, "callded GetPosts")
+func timeToAPI(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
 
-	if req.Offset < 0 || req.Limit < 1 {
-		return &api.GetPostsRsp{}, status.Error(codes.Internal, "Invalid offset or limit!")
+func postToAPI(post *models.Post, likes int64, is_liked bool) *api.Post {
+	return &api.Post{
+		Id:        int64(post.ID),
+		Post:      &api.PostBody{Title: post.Title, Body: post.Body},
+		Author:    &api.UserInfo{Id: int64(post.Author.ID), Name: post.Author.Name},
+		Likes:     likes,
+		IsLiked:   is_liked,
+		Comments:  post.CommentsCount,
+		CreatedAt: timestamppb.New(post.CreatedAt),
+		UpdatedAt: timestamppb.New(post.UpdatedAt),
+		EditedAt:  timeToAPI(post.EditedAt),
 	}
+}
 
-	var request_main_page = req.Offset+req.Limit < cached_posts_limit && req.UserId == 0
-	var posts_rsp []*api.Post
+func commentToAPI(comment *models.Comment, likes int64, is_liked bool) *api.Comment {
+	comment_rsp := &api.Comment{
+		Id:        int64(comment.ID),
+		PostId:    int64(comment.PostRefer),
+		Author:    &api.UserInfo{Id: int64(comment.Author.ID), Name: comment.Author.Name},
+		Body:      comment.Body,
+		Likes:     likes,
+		IsLiked:   is_liked,
+		CreatedAt: timestamppb.New(comment.CreatedAt),
+		UpdatedAt: timestamppb.New(comment.UpdatedAt),
+		EditedAt:  timeToAPI(comment.EditedAt),
+	}
 
-	if request_main_page {
-		// may be cached
-		s.Logger.Info("Redis start to check cached posts")
-		posts, err := rdb.Get(rctx, "cached_posts").Bytes()
-		s.Logger.Info("Redis ended to check cached posts")
-
-		if err != redis.Nil && err != nil {
-			return &api.GetPostsRsp{}, status.Error(codes.Internal, err.Error())
-		} else if err != redis.Nil {
-			err = json.Unmarshal(posts, &posts_rsp)
-			if err != nil {
-				return &api.GetPostsRsp{}, status.Error(codes.Internal, err.Error())
-			}
+	if comment.ParentID != nil {
+		comment_rsp.ParentCommentId = int64(*comment.ParentID)
+	}
 
-			fmt.Println("CACHED:", posts_rsp)
+	// tombstone of deleted comment
+	if comment.DeletedAt.Valid {
+		comment_rsp.Author = nil
+		comment_rsp.Body = ""
+		comment_rsp.Deleted = true
+	}
 
-			return &api.GetPostsRsp{Posts: posts_rsp[req.Offset:min(req.Offset+req.Limit, int64(len(posts_rsp)))]}, nil
-		} else {
-			// not cached
-			// falthrough
-		}
+	return comment_rsp
+}
+
+// postsToAPI hydrates posts for the viewer. Every store is asked once for
+// the whole page, order of posts is preserved.
+func (s *Service) postsToAPI(ctx context.Context, posts []models.Post, user_id int64) ([]*api.Post, error) {
+	post_ids := make([]uint, len(posts))
+	for i, post := range posts {
+		post_ids[i] = post.ID
 	}
 
-	var posts []models.Post
-	if request_main_page {
-		if err := db.Preload("Author").Preload("Comments").Offset(0).Limit(int(cached_posts_limit)).Find(&posts).Error; err != nil {
-			return &api.GetPostsRsp{}, status.Error(codes.Internal, err.Error())
-		}
-	} else {
-		if err := db.Preload("Author").Preload("Comments").Offset(int(req.Offset)).Limit(int(req.Limit)).Find(&posts).Error; err != nil {
-			return &api.GetPostsRsp{}, status.Error(codes.Internal, err.Error())
-		}
+	s.Logger.Info("Likes: start get likes;", zap.Int("posts", len(posts)), zap.Int64("user_id", user_id))
+	start := time.Now()
+	likes, err := s.Likes.PostsLikes(ctx, post_ids, user_id)
+	s.LikesLatency.WithLabelValues("likes_latency").Observe(float64(time.Since(start).Milliseconds()))
+	s.Logger.Info("Likes: ended get likes;", zap.Int("posts", len(posts)), zap.Int64("user_id", user_id))
+	if err != nil {
+		return nil, err
 	}
 
-	var wg sync.WaitGroup
-	mutex := &sync.Mutex{}
-	errs := make(chan error, len(posts))
+	posts_rsp := make([]*api.Post, len(posts))
+	for i := range posts {
+		posts_rsp[i] = postToAPI(&posts[i], likes[i].Count, likes[i].Liked)
+	}
 
-	for _, post := range posts {
-		wg.Add(1)
-		go func(post models.Post, logger *zap.Logger) {
-			defer wg.Done()
-			logger.Info("Redis: start get total likes;", zap.Uint("post_id", post.ID))
-			start := time.Now()
-			likes, err := rdb.SCard(rctx, "post_"+string(post.ID)).Result()
-			s.LikesLatency.WithLabelValues("likes_latency").Observe(float64(time.Since(start).Milliseconds()))
-			logger.Info("Redis: ended get total likes;", zap.Uint("post_id", post.ID))
+	if err := s.fillPostReactions(ctx, posts_rsp, user_id); err != nil {
+		return nil, err
+	}
+	if err := s.fillPostVotes(ctx, posts_rsp, user_id); err != nil {
+		return nil, err
+	}
+	if err := s.fillLikedBy(ctx, posts_rsp, user_id); err != nil {
+		return nil, err
+	}
 
-			if err != nil {
-				errs <- err
-				return
-			}
+	return posts_rsp, nil
+}
 
-			logger.Info("Redis: start get is_liked;", zap.Uint("post_id", post.ID), zap.Int64("user_id", req.UserId))
-			is_liked, err := rdb.SIsMember(rctx, "post_"+string(post.ID), req.UserId).Result()
-			logger.Info("Redis: ended get is_liked;", zap.Uint("post_id", post.ID), zap.Int64("user_id", req.UserId))
+// commentsToAPI hydrates comments for the viewer the same way as postsToAPI.
+func (s *Service) commentsToAPI(ctx context.Context, comments []models.Comment, user_id int64) ([]*api.Comment, error) {
+	comment_ids := make([]uint, len(comments))
+	for i, comment := range comments {
+		comment_ids[i] = comment.ID
+	}
 
-			if err != nil {
-				errs <- err
-				return
-			}
+	s.Logger.Info("Likes: start get likes;", zap.Int("comments", len(comments)), zap.Int64("user_id", user_id))
+	likes, err := s.Likes.CommentsLikes(ctx, comment_ids, user_id)
+	s.Logger.Info("Likes: ended get likes;", zap.Int("comments", len(comments)), zap.Int64("user_id", user_id))
+	if err != nil {
+		return nil, err
+	}
+
+	comments_rsp := make([]*api.Comment, len(comments))
+	for i := range comments {
+		comments_rsp[i] = commentToAPI(&comments[i], likes[i].Count, likes[i].Liked)
+	}
 
-			mutex.Lock()
-			defer mutex.Unlock()
-			posts_rsp = append(posts_rsp,
-				&api.Post{
-					Id:       int64(post.ID),
-					Post:     &api.PostBody{Title: post.Title, Body: post.Body},
-					Author:   &api.UserInfo{Id: int64(post.Author.ID), Name: post.Author.Name},
-					Likes:    likes,
-					IsLiked:  is_liked,
-					Comments: int64(len(post.Comments)),
-				})
-		}(post, s.Logger)
+	if err := s.fillReplyCounts(ctx, comments_rsp); err != nil {
+		return nil, err
+	}
+	if err := s.fillCommentReactions(ctx, comments_rsp, user_id); err != nil {
+		return nil, err
 	}
+	if err := s.fillCommentVotes(ctx, comments_rsp, user_id); err != nil {
+		return nil, err
+	}
+
+	return comments_rsp, nil
+}
+
+func (s *Service) GetPosts(
+	ctx context.Context, req *api.GetPostsReq,
+) (*api.GetPostsRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded GetPosts")
+
+	cursor_order := postsCursorOrder(req.Sort)
 
-	wg.Wait()
-	close(errs)
+	// page_token switches to keyset pagination, offset is ignored then
+	var cursor *pagination.Cursor
+	if req.PageToken != "" {
+		if cursor_order == "" {
+			return &api.GetPostsRsp{}, errs.InvalidArgument("Page token is not supported for this sort order!")
+		}
 
-	if err := <-errs; err != nil {
-		return &api.GetPostsRsp{}, status.Error(codes.Internal, err.Error())
+		c, err := pagination.Decode(req.PageToken, cursor_order)
+		if err != nil {
+			return &api.GetPostsRsp{}, errs.InvalidArgument(err.Error())
+		}
+		cursor = &c
 	}
 
-	sort.Slice(posts_rsp, func(i, j int) bool {
-		return posts_rsp[i].Id < posts_rsp[j].Id
-	})
+	var request_main_page = cursor == nil && req.Offset+req.Limit < cached_posts_limit &&
+		isDefaultPostsSort(req.Sort) && !hasPostsFilters(req)
+
+	if request_main_page {
+		s.Logger.Info("Cache: start to get cached posts")
+		posts_rsp, err := s.PostsCache.Get(ctx, func(load_ctx context.Context) ([]*api.Post, error) {
+			posts, err := s.Posts.Find(load_ctx, storage.PostQuery{Limit: cached_posts_limit})
+			if err != nil {
+				return nil, err
+			}
+			// cached posts are shared by all viewers, is_liked is filled below
+			return s.postsToAPI(load_ctx, posts, 0)
+		})
+		s.Logger.Info("Cache: ended to get cached posts")
+		if err != nil {
+			return &api.GetPostsRsp{}, errs.Internal(err)
+		}
 
-	if !request_main_page {
-		return &api.GetPostsRsp{Posts: posts_rsp}, nil
+		page := postsPage(posts_rsp, req.Offset, req.Limit, cursor_order)
+		if err := s.fillIsLiked(ctx, page.Posts, user_id); err != nil {
+			return &api.GetPostsRsp{}, errs.Internal(err)
+		}
+		if err := s.fillPostReactions(ctx, page.Posts, user_id); err != nil {
+			return &api.GetPostsRsp{}, errs.Internal(err)
+		}
+		if err := s.fillPostVotes(ctx, page.Posts, user_id); err != nil {
+			return &api.GetPostsRsp{}, errs.Internal(err)
+		}
+		if err := s.fillLikedBy(ctx, page.Posts, user_id); err != nil {
+			return &api.GetPostsRsp{}, errs.Internal(err)
+		}
+		return page, nil
+	}
+
+	query := storage.PostQuery{PostFilter: postsFilter(req), Cursor: cursor, Offset: req.Offset, Limit: req.Limit}
+	switch req.Sort {
+	case api.PostSort_POST_SORT_NEWEST:
+		query.Order = storage.PostsNewest
+	case api.PostSort_POST_SORT_MOST_COMMENTED:
+		query.Order = storage.PostsMostCommented
+	case api.PostSort_POST_SORT_TOP_LIKED:
+		query.Order = storage.PostsTopLiked
+	case api.PostSort_POST_SORT_HOT:
+		query.Order = storage.PostsHot
+	case api.PostSort_POST_SORT_TOP_SCORE:
+		query.Order = storage.PostsTopScore
+	}
+	posts, err := s.Posts.Find(ctx, query)
+	if err != nil {
+		return &api.GetPostsRsp{}, errs.Internal(err)
 	}
 
-	// save cache to redis
-	posts_to_cache, err := json.Marshal(posts_rsp)
+	posts_rsp, err := s.postsToAPI(ctx, posts, user_id)
 	if err != nil {
-		return &api.GetPostsRsp{}, status.Error(codes.Internal, err.Error())
+		return &api.GetPostsRsp{}, errs.Internal(err)
 	}
-	s.Logger.Info("Redis start to save cached posts")
-	err = rdb.Set(rctx, "cached_posts", posts_to_cache, 5*time.Second).Err()
-	s.Logger.Info("Redis ended to save cached posts")
+
+	return postsPage(posts_rsp, 0, req.Limit, cursor_order), nil
+}
+
+// fillIsLiked sets is_liked of the viewer for posts in one round trip.
+func (s *Service) fillIsLiked(ctx context.Context, posts []*api.Post, user_id int64) error {
+	if user_id == 0 || len(posts) == 0 {
+		return nil
+	}
+
+	post_ids := make([]uint, len(posts))
+	for i, post := range posts {
+		post_ids[i] = uint(post.Id)
+	}
+
+	is_liked, err := s.Likes.LikedPosts(ctx, post_ids, user_id)
 	if err != nil {
-		return &api.GetPostsRsp{}, status.Error(codes.Internal, err.Error())
+		return err
+	}
+
+	for i, post := range posts {
+		post.IsLiked = is_liked[i]
+	}
+	return nil
+}
+
+// invalidatePostsCache drops main page cache after a change. The change is
+// already saved, so failure is only logged: the cache expires by TTL anyway.
+func (s *Service) invalidatePostsCache(ctx context.Context) {
+	if err := s.PostsCache.Invalidate(context.WithoutCancel(ctx)); err != nil {
+		s.Logger.Error("Failed to invalidate cached posts", zap.Error(err))
+	}
+}
+
+// postsPage cuts page out of posts and sets token pointing to the last post of the page.
+// Token is empty if there is nothing after this page or keyset pagination is not
+// supported, i.e. order is empty.
+func postsPage(posts []*api.Post, offset int64, limit int64, order pagination.Order) *api.GetPostsRsp {
+	page := posts[min(offset, int64(len(posts))):min(offset+limit, int64(len(posts)))]
+
+	var next_page_token string
+	if order != "" && int64(len(page)) == limit {
+		last := page[len(page)-1]
+		next_page_token = pagination.Cursor{CreatedAt: last.CreatedAt.AsTime(), ID: last.Id, Order: order}.Encode()
 	}
 
-	return &api.GetPostsRsp{Posts: posts_rsp[req.Offset:min(req.Offset+req.Limit, int64(len(posts_rsp)))]}, nil
+	return &api.GetPostsRsp{Posts: page, NextPageToken: next_page_token}
 }
 
 func (s *Service) CreatePost(
 	ctx context.Context, req *api.CreatePostReq,
 ) (*api.CreatePostRsp, error) {
-	log.Println("User:", req.UserId, "callded CreatePost")
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.CreatePostRsp{}, err
+	}
+	log.Println("User:", user_id, "callded CreatePost")
 
-	new_post := &models.Post{Title: req.Post.Title, Body: req.Post.Body, AuthorID: uint(req.UserId)}
+	new_post := &models.Post{Title: req.Post.Title, Body: req.Post.Body, AuthorID: uint(user_id)}
 
-	if err := db.Preload("Author").Create(&new_post).First(&new_post).Error; err != nil {
-		return &api.CreatePostRsp{}, status.Error(codes.Internal, err.Error())
+	if err := s.Posts.Create(ctx, new_post); err != nil {
+		return &api.CreatePostRsp{}, errs.Internal(err)
 	}
 
-	return &api.CreatePostRsp{
-		Post: &api.Post{
-			Id:       int64(new_post.ID),
-			Post:     &api.PostBody{Title: new_post.Title, Body: new_post.Body},
-			Author:   &api.UserInfo{Id: int64(new_post.Author.ID), Name: new_post.Author.Name},
-			Likes:    0,
-			IsLiked:  false,
-			Comments: 0,
-		}}, nil
+	s.invalidatePostsCache(ctx)
+
+	return &api.CreatePostRsp{Post: postToAPI(new_post, 0, false)}, nil
 }
 
 func (s *Service) EditPost(
 	ctx context.Context, req *api.EditPostReq,
 ) (*api.EditPostRsp, error) {
-	log.Println("User:", req.UserId, "callded EditPost")
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.EditPostRsp{}, err
+	}
+	log.Println("User:", user_id, "callded EditPost")
 
-	var post *models.Post
-	if err := db.Where("ID = ?", req.PostId).Preload("Author").Preload("Comments").First(&post).Error; err != nil {
-		return &api.EditPostRsp{}, status.Error(codes.Internal, err.Error())
+	post, err := s.Posts.Get(ctx, uint(req.PostId))
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.EditPostRsp{}, errs.NotFound("Post not found!")
+	} else if err != nil {
+		return &api.EditPostRsp{}, errs.Internal(err)
 	}
 
-	if post.AuthorID != uint(req.UserId) {
-		return &api.EditPostRsp{}, status.Error(codes.Unauthenticated, "You are not the author!")
+	if post.AuthorID != uint(user_id) {
+		return &api.EditPostRsp{}, errs.PermissionDenied("You are not the author!")
 	}
 
+	// keep previous version in history
+	revision := &models.PostRevision{PostID: post.ID, Title: post.Title, Body: post.Body}
+
+	edited_at := time.Now()
 	post.Title = req.Post.Title
 	post.Body = req.Post.Body
+	post.EditedAt = &edited_at
 
-	if err := db.Save(&post).Error; err != nil {
-		return &api.EditPostRsp{}, status.Error(codes.Internal, err.Error())
+	if err := s.Posts.Update(ctx, post, revision); err != nil {
+		return &api.EditPostRsp{}, errs.Internal(err)
 	}
 
-	s.Logger.Info("Redis: start get total likes;", zap.Uint("post_id", post.ID))
-	start := time.Now()
-	likes, err := rdb.SCard(rctx, "post_"+string(post.ID)).Result()
-	s.LikesLatency.WithLabelValues("likes_latency").Observe(float64(time.Since(start).Milliseconds()))
-	s.Logger.Info("Redis: ended get total likes;", zap.Uint("post_id", post.ID))
-	if err != nil {
-		return &api.EditPostRsp{}, status.Error(codes.Internal, err.Error())
-	}
+	s.invalidatePostsCache(ctx)
 
-	s.Logger.Info("Redis: start get is_liked;", zap.Uint("post_id", post.ID))
-	is_liked, err := rdb.SIsMember(rctx, "post_"+string(post.ID), req.UserId).Result()
-	s.Logger.Info("Redis: ended get is_liked;", zap.Uint("post_id", post.ID))
+	posts_rsp, err := s.postsToAPI(ctx, []models.Post{*post}, user_id)
 	if err != nil {
-		return &api.EditPostRsp{}, status.Error(codes.Internal, err.Error())
+		return &api.EditPostRsp{}, errs.Internal(err)
 	}
 
-	return &api.EditPostRsp{
-		Post: &api.Post{
-			Id:       int64(post.ID),
-			Post:     &api.PostBody{Title: post.Title, Body: post.Body},
-			Author:   &api.UserInfo{Id: int64(post.Author.ID), Name: post.Author.Name},
-			Likes:    likes,
-			IsLiked:  is_liked,
-			Comments: int64(len(post.Comments)),
-		},
-	}, nil
+	return &api.EditPostRsp{Post: posts_rsp[0]}, nil
 }
 
 func (s *Service) DeletePost(ctx context.Context, req *api.DeletePostReq) (*api.DeletePostRsp, error) {
-	log.Println("User:", req.UserId, "callded DeletePost")
-
-	var post *models.Post
-	if err := db.Where("ID = ?", req.PostId).First(&post).Error; err != nil {
-		return &api.DeletePostRsp{}, status.Error(codes.Internal, err.Error())
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.DeletePostRsp{}, err
 	}
+	log.Println("User:", user_id, "callded DeletePost")
 
-	if post.AuthorID != uint(req.UserId) {
-		return &api.DeletePostRsp{}, status.Error(codes.Unauthenticated, "You are not the author!")
+	post, err := s.Posts.Get(ctx, uint(req.PostId))
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.DeletePostRsp{}, errs.NotFound("Post not found!")
+	} else if err != nil {
+		return &api.DeletePostRsp{}, errs.Internal(err)
 	}
 
-	if err := db.Delete(&post).Error; err != nil {
-		return &api.DeletePostRsp{}, status.Error(codes.Internal, err.Error())
+	if post.AuthorID != uint(user_id) {
+		return &api.DeletePostRsp{}, errs.PermissionDenied("You are not the author!")
 	}
 
-	s.Logger.Info("Redis: start delete all likes;", zap.Uint("post_id", post.ID))
-	_, err := rdb.Del(rctx, "post_"+string(post.ID)).Result()
-	s.Logger.Info("Redis: ended delete all likes;", zap.Uint("post_id", post.ID))
-	if err != nil {
-		return &api.DeletePostRsp{}, status.Error(codes.Internal, err.Error())
+	// post is soft deleted, likes are kept in case the author restores it
+	if err := s.Posts.Delete(ctx, post.ID); err != nil {
+		return &api.DeletePostRsp{}, errs.Internal(err)
 	}
 
+	s.invalidatePostsCache(ctx)
+
 	return &api.DeletePostRsp{}, nil
 }
 
 func (s *Service) LikePost(ctx context.Context, req *api.LikePostReq) (*api.LikePostRsp, error) {
-	log.Println("User:", req.UserId, "callded LikePost")
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.LikePostRsp{}, err
+	}
+	log.Println("User:", user_id, "callded LikePost")
 
-	if req.UserId == 0 {
-		return &api.LikePostRsp{}, status.Error(codes.Unauthenticated, "You are not logged in!")
+	if _, err := s.Posts.Get(ctx, uint(req.PostId)); errors.Is(err, storage.ErrNotFound) {
+		return &api.LikePostRsp{}, errs.NotFound("Post not found!")
+	} else if err != nil {
+		return &api.LikePostRsp{}, errs.Internal(err)
 	}
 
-	s.Logger.Info("Redis: start add like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", req.UserId))
-	liked, err := rdb.SAdd(rctx, "post_"+string(req.PostId), req.UserId).Result()
-	s.Logger.Info("Redis: ended add like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", req.UserId))
+	s.Logger.Info("Likes: start add like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", user_id))
+	liked, err := s.Likes.LikePost(ctx, uint(req.PostId), user_id)
+	s.Logger.Info("Likes: ended add like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", user_id))
 	if err != nil {
-		return &api.LikePostRsp{}, status.Error(codes.Internal, err.Error())
+		return &api.LikePostRsp{}, errs.Internal(err)
 	}
 
-	if liked == 0 {
-		return &api.LikePostRsp{}, status.Error(codes.AlreadyExists, "You already liked this post!")
+	if !liked {
+		return &api.LikePostRsp{}, errs.Conflict("You already liked this post!")
 	}
 
+	s.invalidatePostsCache(ctx)
+
 	return &api.LikePostRsp{}, nil
 }
 
 func (s *Service) DislikePost(ctx context.Context, req *api.DislikePostReq) (*api.DislikePostRsp, error) {
-	log.Println("User:", req.UserId, "callded DislikePost")
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.DislikePostRsp{}, err
+	}
+	log.Println("User:", user_id, "callded DislikePost")
 
-	if req.UserId == 0 {
-		return &api.DislikePostRsp{}, status.Error(codes.Unauthenticated, "You are not logged in!")
+	if _, err := s.Posts.Get(ctx, uint(req.PostId)); errors.Is(err, storage.ErrNotFound) {
+		return &api.DislikePostRsp{}, errs.NotFound("Post not found!")
+	} else if err != nil {
+		return &api.DislikePostRsp{}, errs.Internal(err)
 	}
 
-	s.Logger.Info("Redis: start delete like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", req.UserId))
-	disliked, err := rdb.SRem(rctx, "post_"+string(req.PostId), req.UserId).Result()
-	s.Logger.Info("Redis: ended delete like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", req.UserId))
+	s.Logger.Info("Likes: start delete like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", user_id))
+	disliked, err := s.Likes.UnlikePost(ctx, uint(req.PostId), user_id)
+	s.Logger.Info("Likes: ended delete like;", zap.Int64("post_id", req.PostId), zap.Int64("user_id", user_id))
 	if err != nil {
-		return &api.DislikePostRsp{}, status.Error(codes.Internal, err.Error())
+		return &api.DislikePostRsp{}, errs.Internal(err)
 	}
 
-	if disliked == 0 {
-		return &api.DislikePostRsp{}, status.Error(codes.AlreadyExists, "You already disliked this post!")
+	if !disliked {
+		return &api.DislikePostRsp{}, errs.Conflict("You already disliked this post!")
 	}
 
+	s.invalidatePostsCache(ctx)
+
 	return &api.DislikePostRsp{}, nil
 }
 
 func (s *Service) GetComments(ctx context.Context, req *api.GetCommentsReq) (*api.GetCommentsRsp, error) {
-	log.Println("User:", req.UserId, "callded GetComments")
-
-	var comments []models.Comment
-	if err := db.Where("post_refer = ?", req.PostId).Offset(int(req.Offset)).Limit(int(req.Limit)).Preload("Author").Find(&comments).Error; err != nil {
-		return &api.GetCommentsRsp{}, status.Error(codes.Internal, err.Error())
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded GetComments")
+
+	// page_token switches to keyset pagination, offset is ignored then
+	var cursor *pagination.Cursor
+	if req.PageToken != "" {
+		c, err := pagination.Decode(req.PageToken, pagination.Oldest)
+		if err != nil {
+			return &api.GetCommentsRsp{}, errs.InvalidArgument(err.Error())
+		}
+		cursor = &c
 	}
 
-	var comments_rsp []*api.Comment
+	comments, err := s.Comments.ListByPost(ctx, uint(req.PostId), cursor, req.Offset, req.Limit)
+	if err != nil {
+		return &api.GetCommentsRsp{}, errs.Internal(err)
+	}
 
-	var wg sync.WaitGroup
-	mutex := &sync.Mutex{}
-	errs := make(chan error, len(comments))
+	comments_rsp, err := s.commentsToAPI(ctx, comments, user_id)
+	if err != nil {
+		return &api.GetCommentsRsp{}, errs.Internal(err)
+	}
 
-	for _, comment := range comments {
-		wg.Add(1)
-		go func(comment models.Comment, logger *zap.Logger) {
-			defer wg.Done()
-			logger.Info("Redis: start get likes;", zap.Uint("comment_id", comment.ID))
-			likes, err := rdb.SCard(rctx, "comment_"+string(comment.ID)).Result()
-			logger.Info("Redis: ended get likes;", zap.Uint("comment_id", comment.ID))
-			if err != nil {
-				errs <- err
-				return
-			}
+	var next_page_token string
+	if req.Limit > 0 && int64(len(comments)) == req.Limit {
+		last := comments[len(comments)-1]
+		next_page_token = pagination.Cursor{CreatedAt: last.CreatedAt, ID: int64(last.ID), Order: pagination.Oldest}.Encode()
+	}
 
-			logger.Info("Redis: start get is_liked;", zap.Uint("comment_id", comment.ID), zap.Int64("user_id", req.UserId))
-			is_liked, err := rdb.SIsMember(rctx, "comment_"+string(comment.ID), req.UserId).Result()
-			logger.Info("Redis: ended get is_liked;", zap.Uint("comment_id", comment.ID), zap.Int64("user_id", req.UserId))
-			if err != nil {
-				errs <- err
-				return
-			}
+	return &api.GetCommentsRsp{Comments: comments_rsp, NextPageToken: next_page_token}, nil
+}
 
-			mutex.Lock()
-			defer mutex.Unlock()
-			comments_rsp = append(comments_rsp,
-				&api.Comment{
-					Id:      int64(comment.ID),
-					PostId:  int64(comment.PostRefer),
-					Author:  &api.UserInfo{Id: int64(comment.Author.ID), Name: comment.Author.Name},
-					Body:    comment.Body,
-					Likes:   likes,
-					IsLiked: is_liked,
-				})
-		}(comment, s.Logger)
+func (s *Service) CreateComment(ctx context.Context, req *api.CreateCommentReq) (*api.CreateCommentRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.CreateCommentRsp{}, err
 	}
+	log.Println("User:", user_id, "callded CreateComment")
 
-	wg.Wait()
-	close(errs)
-
-	if err := <-errs; err != nil {
-		return &api.GetCommentsRsp{}, status.Error(codes.Internal, err.Error())
+	// deleted post is kept in the table, so foreign key does not catch it
+	if _, err := s.Posts.Get(ctx, uint(req.PostId)); errors.Is(err, storage.ErrNotFound) {
+		return &api.CreateCommentRsp{}, errs.NotFound("Post not found!")
+	} else if err != nil {
+		return &api.CreateCommentRsp{}, errs.Internal(err)
 	}
 
-	sort.Slice(comments_rsp, func(i, j int) bool {
-		return comments_rsp[i].Id < comments_rsp[j].Id
-	})
+	new_comment := &models.Comment{PostRefer: uint(req.PostId), AuthorID: uint(user_id), Body: req.Body}
 
-	return &api.GetCommentsRsp{Comments: comments_rsp}, nil
-}
+	if req.ParentCommentId != 0 {
+		parent, err := s.Comments.Get(ctx, uint(req.ParentCommentId))
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.CreateCommentRsp{}, errs.NotFound("Parent comment not found!")
+		} else if err != nil {
+			return &api.CreateCommentRsp{}, errs.Internal(err)
+		}
 
-func (s *Service) CreateComment(ctx context.Context, req *api.CreateCommentReq) (*api.CreateCommentRsp, error) {
-	log.Println("User:", req.UserId, "callded CreateComment")
+		if parent.PostRefer != uint(req.PostId) {
+			return &api.CreateCommentRsp{}, errs.InvalidArgument("Parent comment belongs to another post!")
+		}
 
-	new_comment := &models.Comment{PostRefer: uint(req.PostId), AuthorID: uint(req.UserId), Body: req.Body}
+		new_comment.ParentID = &parent.ID
+	}
 
-	if err := db.Preload("Author").Create(&new_comment).First(&new_comment).Error; err != nil {
-		return &api.CreateCommentRsp{}, status.Error(codes.Internal, err.Error())
+	if err := s.Comments.Create(ctx, new_comment); err != nil {
+		return &api.CreateCommentRsp{}, errs.Internal(err)
 	}
 
-	return &api.CreateCommentRsp{
-		Comment: &api.Comment{
-			Id:      int64(new_comment.ID),
-			PostId:  int64(new_comment.PostRefer),
-			Author:  &api.UserInfo{Id: int64(new_comment.Author.ID), Name: new_comment.Author.Name},
-			Body:    new_comment.Body,
-			Likes:   0,
-			IsLiked: false,
-		},
-	}, nil
+	s.invalidatePostsCache(ctx)
+
+	return &api.CreateCommentRsp{Comment: commentToAPI(new_comment, 0, false)}, nil
 }
 
 func (s *Service) EditComment(ctx context.Context, req *api.EditCommentReq) (*api.EditCommentRsp, error) {
-	log.Println("User:", req.UserId, "callded EditComment")
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.EditCommentRsp{}, err
+	}
+	log.Println("User:", user_id, "callded EditComment")
 
-	var comment *models.Comment
-	if err := db.Where("ID = ?", req.CommentId).Preload("Author").First(&comment).Error; err != nil {
-		return &api.EditCommentRsp{}, status.Error(codes.Internal, err.Error())
+	comment, err := s.Comments.Get(ctx, uint(req.CommentId))
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.EditCommentRsp{}, errs.NotFound("Comment not found!")
+	} else if err != nil {
+		return &api.EditCommentRsp{}, errs.Internal(err)
 	}
 
-	if comment.AuthorID != uint(req.UserId) {
-		return &api.EditCommentRsp{}, status.Error(codes.Unauthenticated, "You are not the author!")
+	if comment.AuthorID != uint(user_id) {
+		return &api.EditCommentRsp{}, errs.PermissionDenied("You are not the author!")
 	}
 
-	comment.Body = req.Body
+	// keep previous version in history
+	revision := &models.CommentRevision{CommentID: comment.ID, Body: comment.Body}
 
-	if err := db.Save(&comment).Error; err != nil {
-		return &api.EditCommentRsp{}, status.Error(codes.Internal, err.Error())
-	}
+	edited_at := time.Now()
+	comment.Body = req.Body
+	comment.EditedAt = &edited_at
 
-	s.Logger.Info("Redis: start get total likes;", zap.Uint("comment_id", comment.ID))
-	likes, err := rdb.SCard(rctx, "comment_"+string(comment.ID)).Result()
-	s.Logger.Info("Redis: ended get total likes;", zap.Uint("comment_id", comment.ID))
-	if err != nil {
-		return &api.EditCommentRsp{}, status.Error(codes.Internal, err.Error())
+	if err := s.Comments.Update(ctx, comment, revision); err != nil {
+		return &api.EditCommentRsp{}, errs.Internal(err)
 	}
 
-	s.Logger.Info("Redis: start get is_liked;", zap.Uint("comment_id", comment.ID), zap.Int64("user_id", req.UserId))
-	is_liked, err := rdb.SIsMember(rctx, "comment_"+string(comment.ID), req.UserId).Result()
-	s.Logger.Info("Redis: ended get is_liked;", zap.Uint("comment_id", comment.ID), zap.Int64("user_id", req.UserId))
+	comments_rsp, err := s.commentsToAPI(ctx, []models.Comment{*comment}, user_id)
 	if err != nil {
-		return &api.EditCommentRsp{}, status.Error(codes.Internal, err.Error())
+		return &api.EditCommentRsp{}, errs.Internal(err)
 	}
 
-	return &api.EditCommentRsp{
-		Comment: &api.Comment{
-			Id:      int64(comment.ID),
-			PostId:  int64(comment.PostRefer),
-			Author:  &api.UserInfo{Id: int64(comment.Author.ID), Name: comment.Author.Name},
-			Body:    comment.Body,
-			Likes:   likes,
-			IsLiked: is_liked,
-		},
-	}, nil
+	return &api.EditCommentRsp{Comment: comments_rsp[0]}, nil
 }
 
 func (s *Service) DeleteComment(ctx context.Context, req *api.DeleteCommentReq) (*api.DeleteCommentRsp, error) {
-	log.Println("User:", req.UserId, "callded DeleteComment")
-
-	var comment *models.Comment
-	if err := db.Where("ID = ?", req.CommentId).First(&comment).Error; err != nil {
-		return &api.DeleteCommentRsp{}, status.Error(codes.Internal, err.Error())
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.DeleteCommentRsp{}, err
 	}
+	log.Println("User:", user_id, "callded DeleteComment")
 
-	if comment.AuthorID != uint(req.UserId) {
-		return &api.DeleteCommentRsp{}, status.Error(codes.Unauthenticated, "You are not the author!")
+	comment, err := s.Comments.Get(ctx, uint(req.CommentId))
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.DeleteCommentRsp{}, errs.NotFound("Comment not found!")
+	} else if err != nil {
+		return &api.DeleteCommentRsp{}, errs.Internal(err)
 	}
 
-	if err := db.Delete(&comment).Error; err != nil {
-		return &api.DeleteCommentRsp{}, status.Error(codes.Internal, err.Error())
+	if comment.AuthorID != uint(user_id) {
+		return &api.DeleteCommentRsp{}, errs.PermissionDenied("You are not the author!")
 	}
 
-	s.Logger.Info("Redis: start delete all likes;", zap.Uint("comment_id", comment.ID))
-	_, err := rdb.Del(rctx, "comment_"+string(comment.ID)).Result()
-	s.Logger.Info("Redis: ended delete all likes;", zap.Uint("comment_id", comment.ID))
-	if err != nil {
-		return &api.DeleteCommentRsp{}, status.Error(codes.Internal, err.Error())
+	// comment is soft deleted, likes are kept in case the author restores it
+	if err := s.Comments.Delete(ctx, comment.ID); err != nil {
+		return &api.DeleteCommentRsp{}, errs.Internal(err)
 	}
 
+	s.invalidatePostsCache(ctx)
+
 	return &api.DeleteCommentRsp{}, nil
 }
 
 func (s *Service) LikeComment(ctx context.Context, req *api.LikeCommentReq) (*api.LikeCommentRsp, error) {
-	log.Println("User:", req.UserId, "callded LikeComment")
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.LikeCommentRsp{}, err
+	}
+	log.Println("User:", user_id, "callded LikeComment")
 
-	if req.UserId == 0 {
-		return &api.LikeCommentRsp{}, status.Error(codes.Unauthenticated, "You are not logged in!")
+	if _, err := s.Comments.Get(ctx, uint(req.CommentId)); errors.Is(err, storage.ErrNotFound) {
+		return &api.LikeCommentRsp{}, errs.NotFound("Comment not found!")
+	} else if err != nil {
+		return &api.LikeCommentRsp{}, errs.Internal(err)
 	}
 
-	s.Logger.Info("Redis: start add like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", req.UserId))
-	liked, err := rdb.SAdd(rctx, "comment_"+string(req.CommentId), req.UserId).Result()
-	s.Logger.Info("Redis: ended add like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", req.UserId))
+	s.Logger.Info("Likes: start add like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", user_id))
+	liked, err := s.Likes.LikeComment(ctx, uint(req.CommentId), user_id)
+	s.Logger.Info("Likes: ended add like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", user_id))
 	if err != nil {
-		return &api.LikeCommentRsp{}, status.Error(codes.Internal, err.Error())
+		return &api.LikeCommentRsp{}, errs.Internal(err)
 	}
 
-	if liked == 0 {
-		return &api.LikeCommentRsp{}, status.Error(codes.AlreadyExists, "You already liked this comment!")
+	if !liked {
+		return &api.LikeCommentRsp{}, errs.Conflict("You already liked this comment!")
 	}
 
 	return &api.LikeCommentRsp{}, nil
 }
 
 func (s *Service) DislikeComment(ctx context.Context, req *api.DislikeCommentReq) (*api.DislikeCommentRsp, error) {
-	log.Println("User:", req.UserId, "callded DislikeComment")
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.DislikeCommentRsp{}, err
+	}
+	log.Println("User:", user_id, "callded DislikeComment")
 
-	if req.UserId == 0 {
-		return &api.DislikeCommentRsp{}, status.Error(codes.Unauthenticated, "You are not logged in!")
+	if _, err := s.Comments.Get(ctx, uint(req.CommentId)); errors.Is(err, storage.ErrNotFound) {
+		return &api.DislikeCommentRsp{}, errs.NotFound("Comment not found!")
+	} else if err != nil {
+		return &api.DislikeCommentRsp{}, errs.Internal(err)
 	}
 
-	s.Logger.Info("Redis: start delete like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", req.UserId))
-	disliked, err := rdb.SRem(rctx, "comment_"+string(req.CommentId), req.UserId).Result()
-	s.Logger.Info("Redis: ended delete like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", req.UserId))
+	s.Logger.Info("Likes: start delete like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", user_id))
+	disliked, err := s.Likes.UnlikeComment(ctx, uint(req.CommentId), user_id)
+	s.Logger.Info("Likes: ended delete like;", zap.Int64("comment_id", req.CommentId), zap.Int64("user_id", user_id))
 	if err != nil {
-		return &api.DislikeCommentRsp{}, status.Error(codes.Internal, err.Error())
+		return &api.DislikeCommentRsp{}, errs.Internal(err)
 	}
 
-	if disliked == 0 {
-		return &api.DislikeCommentRsp{}, status.Error(codes.AlreadyExists, "You already disliked this comment!")
+	if !disliked {
+		return &api.DislikeCommentRsp{}, errs.Conflict("You already disliked this comment!")
 	}
 
 	return &api.DislikeCommentRsp{}, nil
@@ -542,11 +701,6 @@ func connectDB() {
 	if err != nil {
 		panic(err)
 	}
-
-	err = db.AutoMigrate(&models.User{}, &models.Post{}, &models.Comment{})
-	if err != nil {
-		panic(err)
-	}
 }
 
 func connectRedis() {
@@ -560,6 +714,37 @@ func connectRedis() {
 	// rdb = redis.NewClient(&redis.Options{Addr: "89.169.8.65:6379", Password: os.Getenv("REDIS_PASSWORD"), DB: 0})
 }
 
+func newTokenManager(storage_backend string) *auth.TokenManager {
+	authSecret := os.Getenv("AUTH_SECRET")
+	if authSecret == "" {
+		if storage_backend != storage_memory {
+			panic("AUTH_SECRET is not set")
+		}
+
+		// Memory sessions do not survive restart, so random secret is enough.
+		var err error
+		authSecret, err = auth.NewRefreshToken()
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return auth.NewTokenManager([]byte(authSecret), access_token_ttl)
+}
+
+func newPostsCache(store cache.Store) *cache.PostsCache {
+	ttl := default_cached_posts_ttl
+	if value, exists := os.LookupEnv("CACHED_POSTS_TTL"); exists {
+		var err error
+		ttl, err = time.ParseDuration(value)
+		if err != nil || ttl <= 0 {
+			panic("CACHED_POSTS_TTL must be a positive duration, e.g. 5s")
+		}
+	}
+
+	return cache.NewPostsCache(store, ttl)
+}
+
 func fillDBIfEmpty() {
 	if db == nil {
 		panic("DB is not connected")
@@ -595,23 +780,41 @@ func fillDBIfEmpty() {
 		log.Fatalf("Failed to insert orders: %v", err)
 	}
 
+	// comments are created by gorm directly, so posts count them here
+	if _, err := pgstorage.RepairCommentsCount(context.Background(), db); err != nil {
+		log.Fatalf("Failed to repair comments count: %v", err)
+	}
+
 	fmt.Println("Данные созданы")
 }
 
-func main() {
-	logger, err := zap.NewProduction()
+// newPostgresService connects to postgres and redis and returns service with
+// stores on top of them.
+func newPostgresService() *Service {
+	connectDB()
+	connectRedis()
+
+	applied, err := newMigrator().Up(context.Background())
 	if err != nil {
-		panic(err)
+		log.Fatalf("Failed to migrate database: %v", err)
+	}
+	for _, migration := range applied {
+		log.Printf("Applied migration %s", migration)
 	}
-	defer logger.Sync()
 
-	connectDB()
-	connectRedis()
 	fillDBIfEmpty()
 
+	if err := pgstorage.BackfillSearchVectors(db); err != nil {
+		log.Fatalf("Failed to fill search vectors: %v", err)
+	}
+	likes := redisstorage.NewLikeStore(db, rdb)
+	if err := likes.Warm(rctx); err != nil {
+		log.Fatalf("Failed to warm likes cache: %v", err)
+	}
+
 	// just to test DB
 	var posts []models.Post
-	db.Preload("Author").Preload("Comments").Find(&posts)
+	db.Preload("Author").Find(&posts)
 	for _, post := range posts {
 		fmt.Println(post)
 	}
@@ -622,6 +825,125 @@ func main() {
 		fmt.Println(comment)
 	}
 
+	return &Service{
+		PostsCache:    newPostsCache(cache.NewRedisStore(rdb)),
+		Posts:         pgstorage.NewPostRepository(db),
+		Comments:      pgstorage.NewCommentRepository(db),
+		Users:         pgstorage.NewUserRepository(db),
+		Likes:         likes,
+		Reactions:     redisstorage.NewReactionStore(db, rdb),
+		ReactionTypes: reactionTypes(),
+		Votes:         pgstorage.NewVoteStore(db),
+		Sessions:      redisstorage.NewSessionStore(rdb),
+		Feed:          redisstorage.NewFeedStore(db, rdb),
+		Outbox:        pgstorage.NewOutbox(db),
+	}
+}
+
+// newMemoryService returns service with all data kept in process memory.
+// Nothing survives restart, the mode is meant for local development and CI.
+func newMemoryService() *Service {
+	mdb := memory.NewDB()
+	return &Service{
+		PostsCache:    newPostsCache(cache.NewMemoryStore()),
+		Posts:         memory.NewPostRepository(mdb),
+		Comments:      memory.NewCommentRepository(mdb),
+		Users:         memory.NewUserRepository(mdb),
+		Likes:         memory.NewLikeStore(mdb),
+		Reactions:     memory.NewReactionStore(),
+		ReactionTypes: reactionTypes(),
+		Votes:         memory.NewVoteStore(mdb),
+		Sessions:      memory.NewSessionStore(),
+		Feed:          memory.NewFeedStore(mdb),
+		Outbox:        memory.NewOutbox(mdb),
+	}
+}
+
+// newGRPCServer returns gRPC server of the service with all interceptors.
+func newGRPCServer(s *Service) *grpc.Server {
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpc_zap.UnaryServerInterceptor(s.Logger),
+			grpc_prometheus.UnaryServerInterceptor,
+			errs.UnaryServerInterceptor(s.Logger),
+			grpc_auth.UnaryServerInterceptor(s.Tokens.AuthFunc),
+			validate.UnaryServerInterceptor(),
+		),
+	)
+	api.RegisterServiceServer(grpcServer, s)
+	reflection.Register(grpcServer)
+	return grpcServer
+}
+
+// newGatewayMux returns REST gateway proxying to the gRPC server behind conn,
+// together with swagger UI.
+func newGatewayMux(ctx context.Context, conn *grpc.ClientConn) (*http.ServeMux, error) {
+	gwmux := runtime.NewServeMux()
+
+	if err := api.RegisterServiceHandler(ctx, gwmux, conn); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+
+	mux.Handle("/", gwmux)
+
+	mux.HandleFunc("/swagger-ui/swagger.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(swaggerData)
+	})
+
+	fSys, err := fs.Sub(swaggerFiles, "swagger-ui")
+	if err != nil {
+		return nil, err
+	}
+
+	mux.Handle("/swagger-ui/", http.StripPrefix("/swagger-ui/", http.FileServer(http.FS(fSys))))
+
+	return mux, nil
+}
+
+func main() {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	storage_backend := flag.String("storage", storage_postgres, "storage backend: postgres, or memory to run without postgres and redis")
+	flag.Parse()
+
+	if flag.Arg(0) == "migrate" {
+		if err := migrateCommand(flag.Args()[1:]); err != nil {
+			log.Fatalf("Failed to migrate database: %v", err)
+		}
+		return
+	}
+	if flag.Arg(0) == "migrate-redis-keys" {
+		if err := migrateRedisKeysCommand(); err != nil {
+			log.Fatalf("Failed to migrate redis keys: %v", err)
+		}
+		return
+	}
+	if flag.Arg(0) == "repair-comments-count" {
+		if err := repairCommentsCountCommand(); err != nil {
+			log.Fatalf("Failed to repair comments count: %v", err)
+		}
+		return
+	}
+
+	var s *Service
+	switch *storage_backend {
+	case storage_postgres:
+		s = newPostgresService()
+	case storage_memory:
+		s = newMemoryService()
+	default:
+		log.Fatalf("Unknown storage %q, expected %s or %s", *storage_backend, storage_postgres, storage_memory)
+	}
+	log.Printf("Using %s storage", *storage_backend)
+
+	tokens := newTokenManager(*storage_backend)
+
 	// Для анализа latency запросов:
 	// 1. Counter плохо подходит. Можно завести два счётчика - сумму latency и
 	//    количество запросов, чтобы считать среднее latency, но это малоинформативно.
@@ -661,19 +983,13 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	s := &Service{
-		Logger:       logger,
-		LikesLatency: likes_latency,
-	}
+	s.Logger = logger
+	s.LikesLatency = likes_latency
+	s.Tokens = tokens
 
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			grpc_zap.UnaryServerInterceptor(logger),
-			grpc_prometheus.UnaryServerInterceptor,
-		),
-	)
-	api.RegisterServiceServer(grpcServer, s)
-	reflection.Register(grpcServer)
+	go s.runWorkers(context.Background())
+
+	grpcServer := newGRPCServer(s)
 
 	go func() {
 		log.Println("gRPC server started on :50051")
@@ -687,28 +1003,11 @@ func main() {
 		log.Fatalln("Failed to dial server:", err)
 	}
 
-	gwmux := runtime.NewServeMux()
-
-	ctx := context.Background()
-	if err := api.RegisterServiceHandler(ctx, gwmux, conn); err != nil {
-		log.Fatalln("Failed to register gateway:", err)
-	}
-
-	mux := http.NewServeMux()
-
-	mux.Handle("/", gwmux)
-
-	mux.HandleFunc("/swagger-ui/swagger.json", func(w http.ResponseWriter, r *http.Request) {
-		_, _ = w.Write(swaggerData)
-	})
-
-	fSys, err := fs.Sub(swaggerFiles, "swagger-ui")
+	mux, err := newGatewayMux(context.Background(), conn)
 	if err != nil {
-		panic(err)
+		log.Fatalln("Failed to register gateway:", err)
 	}
 
-	mux.Handle("/swagger-ui/", http.StripPrefix("/swagger-ui/", http.FileServer(http.FS(fSys))))
-
 	gwServer := &http.Server{
 		Addr:    ":8090",
 		Handler: mux,
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	pgstorage "go_1C/storage/postgres"
+	"go_1C/storage/postgres/migrations"
+	redisstorage "go_1C/storage/redis"
+)
+
+const migrate_usage = "usage: migrate up | down [steps] | status"
+
+func newMigrator() *migrations.Migrator {
+	sql_db, err := db.DB()
+	if err != nil {
+		panic(err)
+	}
+
+	migrator, err := migrations.New(sql_db)
+	if err != nil {
+		panic(err)
+	}
+	return migrator
+}
+
+// migrateCommand runs `migrate up|down|status` subcommand of the binary.
+// Down rolls back one migration unless number of steps is given.
+func migrateCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrate_usage)
+	}
+
+	connectDB()
+	migrator := newMigrator()
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			log.Printf("Applied migration %s", migration)
+		}
+		if err == nil && len(applied) == 0 {
+			log.Println("Database is up to date")
+		}
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				return errors.New(migrate_usage)
+			}
+		}
+
+		rolled_back, err := migrator.Down(ctx, steps)
+		for _, migration := range rolled_back {
+			log.Printf("Rolled back migration %s", migration)
+		}
+		return err
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			applied_at := "pending"
+			if status.AppliedAt != nil {
+				applied_at = status.AppliedAt.UTC().Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%-40s %s\n", status.Migration, applied_at)
+		}
+		return nil
+
+	default:
+		return errors.New(migrate_usage)
+	}
+}
+
+// migrateRedisKeysCommand runs `migrate-redis-keys` subcommand of the binary,
+// it moves likes sets and refresh tokens to versioned keys and drops legacy
+// timelines once before the new version starts.
+func migrateRedisKeysCommand() error {
+	connectRedis()
+
+	migration, err := redisstorage.MigrateLikesKeys(rctx, rdb)
+	if err != nil {
+		return err
+	}
+	log.Printf("Moved %d likes sets", migration.Moved)
+	if migration.Ambiguous > 0 {
+		log.Printf("Left %d legacy likes sets shared by several ids as is", migration.Ambiguous)
+	}
+
+	tokens, err := redisstorage.MigrateRefreshTokenKeys(rctx, rdb)
+	if err != nil {
+		return err
+	}
+	log.Printf("Moved %d refresh tokens", tokens)
+
+	timelines, err := redisstorage.DropLegacyTimelines(rctx, rdb)
+	if err != nil {
+		return err
+	}
+	log.Printf("Dropped %d legacy timelines", timelines)
+	return nil
+}
+
+// repairCommentsCountCommand runs `repair-comments-count` subcommand of the
+// binary, it recomputes comments counters of posts from the comments table.
+func repairCommentsCountCommand() error {
+	connectDB()
+
+	repaired, err := pgstorage.RepairCommentsCount(context.Background(), db)
+	if err != nil {
+		return err
+	}
+	log.Printf("Repaired comments count of %d posts", repaired)
+	return nil
+}
@@ -1,23 +1,166 @@
 package models
 
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
 type User struct {
-	ID   uint   `gorm:"primaryKey"`
-	Name string `gortm:"size:50;not null"`
+	ID        uint   `gorm:"primaryKey"`
+	Name      string `gorm:"size:50;not null"`
+	Bio       string `gorm:"type:text;not null;default:''"`
+	AvatarURL string `gorm:"size:255;not null;default:''"`
+}
+
+type Credentials struct {
+	UserID       uint   `gorm:"primaryKey"`
+	User         User   `gorm:"constraint:OnDelete:CASCADE"`
+	Login        string `gorm:"size:50;not null;uniqueIndex"`
+	PasswordHash []byte `gorm:"not null"`
+	Salt         []byte `gorm:"not null"`
+}
+
+// Follow means that follower sees posts of followee in the feed.
+type Follow struct {
+	FollowerID uint `gorm:"primaryKey"`
+	FolloweeID uint `gorm:"primaryKey;index"`
+	Follower   User `gorm:"foreignKey:FollowerID;constraint:OnDelete:CASCADE"`
+	Followee   User `gorm:"foreignKey:FolloweeID;constraint:OnDelete:CASCADE"`
+	CreatedAt  time.Time
 }
 
 type Post struct {
-	ID       uint   `gorm:"primaryKey"`
-	Title    string `gortm:"size:100;not null"`
-	Body     string `gorm:"type:text;not null"`
-	Author   User
-	AuthorID uint      `gorm:"not null"`
-	Comments []Comment `gorm:"foreignKey:PostRefer"`
+	ID        uint      `gorm:"primaryKey"`
+	Title     string    `gorm:"size:100;not null"`
+	Body      string    `gorm:"type:text;not null"`
+	Author    User      `gorm:"constraint:OnDelete:CASCADE"`
+	AuthorID  uint      `gorm:"not null;index:idx_posts_author_id,priority:1"`
+	Comments  []Comment `gorm:"foreignKey:PostRefer;constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time `gorm:"index:idx_posts_created_at,priority:1;index:idx_posts_author_id,priority:2"`
+	UpdatedAt time.Time
+	EditedAt  *time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	// Number of not deleted comments, it is maintained by the comments
+	// repository with raw SQL. Comments are not loaded with the post.
+	CommentsCount int64 `gorm:"not null;default:0;<-:false"`
+	// Number of likes, it is maintained by the likes store with raw SQL.
+	LikesCount int64 `gorm:"not null;default:0;<-:false"`
+	// Numbers of votes, they are maintained by the votes store with raw SQL.
+	Upvotes   int64 `gorm:"not null;default:0;<-:false"`
+	Downvotes int64 `gorm:"not null;default:0;<-:false"`
+	// Full-text search document, it is maintained by the service with raw SQL.
+	SearchVector string `gorm:"type:tsvector;index:,type:gin;->:false;<-:false"`
 }
 
 type Comment struct {
-	ID        uint `gorm:"primaryKey"`
-	PostRefer uint `gorm:"not null"`
-	Author    User
-	AuthorID  uint   `gorm:"not null"`
+	ID        uint      `gorm:"primaryKey"`
+	PostRefer uint      `gorm:"not null;index:idx_comments_post_refer,priority:1"`
+	ParentID  *uint     `gorm:"index"`
+	Author    User      `gorm:"constraint:OnDelete:CASCADE"`
+	AuthorID  uint      `gorm:"not null;index"`
+	Body      string    `gorm:"type:text;not null"`
+	CreatedAt time.Time `gorm:"index:idx_comments_post_refer,priority:2"`
+	UpdatedAt time.Time
+	EditedAt  *time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	// Number of likes, it is maintained by the likes store with raw SQL.
+	LikesCount int64 `gorm:"not null;default:0;<-:false"`
+	// Numbers of votes, they are maintained by the votes store with raw SQL.
+	Upvotes   int64 `gorm:"not null;default:0;<-:false"`
+	Downvotes int64 `gorm:"not null;default:0;<-:false"`
+	// Full-text search document, it is maintained by the service with raw SQL.
+	SearchVector string `gorm:"type:tsvector;index:,type:gin;->:false;<-:false"`
+}
+
+// PostRevision keeps previous version of the post, it is saved on every edit.
+type PostRevision struct {
+	ID        uint   `gorm:"primaryKey"`
+	PostID    uint   `gorm:"not null;index"`
+	Title     string `gorm:"size:100;not null"`
+	Body      string `gorm:"type:text;not null"`
+	CreatedAt time.Time
+}
+
+// CommentRevision keeps previous version of the comment, it is saved on every edit.
+type CommentRevision struct {
+	ID        uint   `gorm:"primaryKey"`
+	CommentID uint   `gorm:"not null;index"`
 	Body      string `gorm:"type:text;not null"`
+	CreatedAt time.Time
+}
+
+// PostLike is a like of the post by the user.
+type PostLike struct {
+	PostID    uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"primaryKey;index"`
+	Post      Post `gorm:"constraint:OnDelete:CASCADE"`
+	User      User `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+// CommentLike is a like of the comment by the user.
+type CommentLike struct {
+	CommentID uint    `gorm:"primaryKey"`
+	UserID    uint    `gorm:"primaryKey;index"`
+	Comment   Comment `gorm:"constraint:OnDelete:CASCADE"`
+	User      User    `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+// PostReaction is an emoji reaction to the post by the user.
+type PostReaction struct {
+	PostID    uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"primaryKey;index"`
+	Reaction  string `gorm:"primaryKey;size:32"`
+	Post      Post   `gorm:"constraint:OnDelete:CASCADE"`
+	User      User   `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+// CommentReaction is an emoji reaction to the comment by the user.
+type CommentReaction struct {
+	CommentID uint    `gorm:"primaryKey"`
+	UserID    uint    `gorm:"primaryKey;index"`
+	Reaction  string  `gorm:"primaryKey;size:32"`
+	Comment   Comment `gorm:"constraint:OnDelete:CASCADE"`
+	User      User    `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+}
+
+// PostVote is an up or down vote of the user for the post.
+type PostVote struct {
+	PostID uint `gorm:"primaryKey"`
+	UserID uint `gorm:"primaryKey;index"`
+	// 1 or -1
+	Value     int8 `gorm:"type:smallint;not null"`
+	Post      Post `gorm:"constraint:OnDelete:CASCADE"`
+	User      User `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CommentVote is an up or down vote of the user for the comment.
+type CommentVote struct {
+	CommentID uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"primaryKey;index"`
+	// 1 or -1
+	Value     int8    `gorm:"type:smallint;not null"`
+	Comment   Comment `gorm:"constraint:OnDelete:CASCADE"`
+	User      User    `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OutboxEvent is a side effect on redis of a change in postgres. It is saved
+// in the same transaction as the change and applied later by the worker.
+type OutboxEvent struct {
+	ID        uint   `gorm:"primaryKey"`
+	Kind      string `gorm:"not null"`
+	EntityID  uint   `gorm:"not null"`
+	Attempts  int    `gorm:"not null;default:0"`
+	LastError string `gorm:"type:text;not null;default:''"`
+	CreatedAt time.Time
+	// DeadAt is set when the event failed too many times, it is skipped then.
+	DeadAt *time.Time
 }
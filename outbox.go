@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+const outbox_poll_interval = time.Second
+const outbox_batch_size = 100
+const likes_reconcile_interval = time.Hour
+
+// applyEvent makes side effect of the outbox event on the likes, the
+// reactions or the feed store.
+// Unknown events are dropped, otherwise they would block the outbox forever.
+func (s *Service) applyEvent(ctx context.Context, event models.OutboxEvent) error {
+	switch event.Kind {
+	case storage.EventPostCreated, storage.EventPostRestored:
+		return s.addToFeeds(ctx, event.EntityID)
+	case storage.EventPostDeleted:
+		return s.removeFromFeeds(ctx, event.EntityID)
+	case storage.EventPostLikesStale:
+		return s.Likes.RefreshPostLikes(ctx, event.EntityID)
+	case storage.EventCommentLikesStale:
+		return s.Likes.RefreshCommentLikes(ctx, event.EntityID)
+	case storage.EventPostReactionsStale:
+		return s.Reactions.RefreshPostReactions(ctx, event.EntityID)
+	case storage.EventCommentReactionsStale:
+		return s.Reactions.RefreshCommentReactions(ctx, event.EntityID)
+	default:
+		s.Logger.Error("Unknown outbox event", zap.String("kind", event.Kind), zap.Uint("id", event.ID))
+		return nil
+	}
+}
+
+// removeFromFeeds takes deleted post out of feeds. Post removed from the
+// database meanwhile is skipped.
+func (s *Service) removeFromFeeds(ctx context.Context, post_id uint) error {
+	post, err := s.Posts.GetUnscoped(ctx, post_id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return s.Feed.RemovePost(ctx, post)
+}
+
+// addToFeeds delivers new or restored post to feeds. Post deleted meanwhile
+// is skipped, its own event removes it.
+func (s *Service) addToFeeds(ctx context.Context, post_id uint) error {
+	post, err := s.Posts.Get(ctx, post_id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return s.Feed.AddPost(ctx, post)
+}
+
+// processOutbox applies all pending events or stops at the first failure.
+// Dead events are logged and skipped.
+func (s *Service) processOutbox(ctx context.Context) error {
+	for {
+		applied, err := s.Outbox.Process(ctx, outbox_batch_size, func(event models.OutboxEvent) error {
+			return s.applyEvent(ctx, event)
+		})
+		var dead *storage.DeadEventError
+		if errors.As(err, &dead) {
+			s.Logger.Error("Outbox event is dead, it is skipped", zap.String("kind", dead.Event.Kind), zap.Uint("id", dead.Event.ID), zap.Uint("entity_id", dead.Event.EntityID), zap.Error(dead.Err))
+			continue
+		}
+		if err != nil || applied < outbox_batch_size {
+			return err
+		}
+	}
+}
+
+// reconcileLikes drops likes of posts and comments which are removed from
+// the database, e.g. by cascades, or were liked before likes were checked.
+func (s *Service) reconcileLikes(ctx context.Context) error {
+	post_ids, err := s.Likes.LikedPostIDs(ctx)
+	if err != nil {
+		return err
+	}
+	existing_posts, err := s.Posts.ExistingIDs(ctx, post_ids)
+	if err != nil {
+		return err
+	}
+	orphaned_posts := missingIDs(post_ids, existing_posts)
+	if err := s.Likes.DeletePostLikes(ctx, orphaned_posts); err != nil {
+		return err
+	}
+
+	comment_ids, err := s.Likes.LikedCommentIDs(ctx)
+	if err != nil {
+		return err
+	}
+	existing_comments, err := s.Comments.ExistingIDs(ctx, comment_ids)
+	if err != nil {
+		return err
+	}
+	orphaned_comments := missingIDs(comment_ids, existing_comments)
+	if err := s.Likes.DeleteCommentLikes(ctx, orphaned_comments); err != nil {
+		return err
+	}
+
+	if len(orphaned_posts) > 0 || len(orphaned_comments) > 0 {
+		s.Logger.Info("Likes: dropped orphaned likes;", zap.Int("posts", len(orphaned_posts)), zap.Int("comments", len(orphaned_comments)))
+	}
+	return nil
+}
+
+func missingIDs(ids []uint, existing []uint) []uint {
+	found := make(map[uint]bool, len(existing))
+	for _, id := range existing {
+		found[id] = true
+	}
+
+	missing := []uint{}
+	for _, id := range ids {
+		if !found[id] {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// runWorkers applies outbox events and reconciles likes until ctx is done.
+func (s *Service) runWorkers(ctx context.Context) {
+	outbox := time.NewTicker(outbox_poll_interval)
+	defer outbox.Stop()
+	reconcile := time.NewTicker(likes_reconcile_interval)
+	defer reconcile.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-outbox.C:
+			if err := s.processOutbox(ctx); err != nil {
+				s.Logger.Error("Failed to process outbox", zap.Error(err))
+			}
+		case <-reconcile.C:
+			if err := s.reconcileLikes(ctx); err != nil {
+				s.Logger.Error("Failed to reconcile likes", zap.Error(err))
+			}
+		}
+	}
+}
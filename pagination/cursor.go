@@ -0,0 +1,59 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var ErrInvalidToken = errors.New("invalid page token")
+
+var ErrOrderMismatch = errors.New("page token belongs to another sort order")
+
+// Order of items the cursor walks through. Token of one order must not be
+// used with another, it would point to the wrong place.
+type Order string
+
+const (
+	Oldest Order = "oldest"
+	Newest Order = "newest"
+)
+
+// Cursor points to the last item of the previous page. Items are ordered by
+// (created_at, id), id breaks ties between items created at the same moment.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        int64
+	Order     Order
+}
+
+type cursorJSON struct {
+	CreatedAt int64  `json:"t"`
+	ID        int64  `json:"i"`
+	Order     string `json:"o"`
+}
+
+// Encode returns opaque page token for the cursor.
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(cursorJSON{CreatedAt: c.CreatedAt.UnixMicro(), ID: c.ID, Order: string(c.Order)})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode parses page token created by Encode for items in the given order.
+func Decode(token string, order Order) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, ErrInvalidToken
+	}
+
+	var c cursorJSON
+	if err := json.Unmarshal(data, &c); err != nil || c.ID <= 0 {
+		return Cursor{}, ErrInvalidToken
+	}
+	if Order(c.Order) != order {
+		return Cursor{}, ErrOrderMismatch
+	}
+
+	return Cursor{CreatedAt: time.UnixMicro(c.CreatedAt).UTC(), ID: c.ID, Order: order}, nil
+}
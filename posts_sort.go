@@ -0,0 +1,42 @@
+package main
+
+import (
+	api "go_1C/api"
+	"go_1C/pagination"
+	"go_1C/storage"
+)
+
+func isDefaultPostsSort(sort api.PostSort) bool {
+	return sort == api.PostSort_POST_SORT_UNSPECIFIED || sort == api.PostSort_POST_SORT_OLDEST
+}
+
+// postsCursorOrder returns order of page tokens of the sort, it is empty if
+// the sort supports offset pagination only.
+func postsCursorOrder(sort api.PostSort) pagination.Order {
+	switch {
+	case isDefaultPostsSort(sort):
+		return pagination.Oldest
+	case sort == api.PostSort_POST_SORT_NEWEST:
+		return pagination.Newest
+	default:
+		return ""
+	}
+}
+
+// postsFilter takes author and time window filters of the request.
+func postsFilter(req *api.GetPostsReq) storage.PostFilter {
+	filter := storage.PostFilter{AuthorID: uint(req.AuthorId)}
+	if req.CreatedAfter != nil {
+		created_after := req.CreatedAfter.AsTime()
+		filter.CreatedAfter = &created_after
+	}
+	if req.CreatedBefore != nil {
+		created_before := req.CreatedBefore.AsTime()
+		filter.CreatedBefore = &created_before
+	}
+	return filter
+}
+
+func hasPostsFilters(req *api.GetPostsReq) bool {
+	return req.AuthorId != 0 || req.CreatedAfter != nil || req.CreatedBefore != nil
+}
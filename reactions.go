@@ -0,0 +1,235 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.uber.org/zap"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/storage"
+)
+
+// Reactions allowed by default, REACTIONS overrides them with a comma
+// separated list. Reaction like_reaction is kept by the likes store.
+const default_reactions = "like,love,laugh,sad,angry"
+const like_reaction = "like"
+
+var reaction_name = regexp.MustCompile(`^[a-z_]{1,32}$`)
+
+func reactionTypes() []string {
+	value, exists := os.LookupEnv("REACTIONS")
+	if !exists {
+		value = default_reactions
+	}
+
+	reactions := strings.Split(value, ",")
+	for _, reaction := range reactions {
+		if !reaction_name.MatchString(reaction) {
+			panic("REACTIONS must be a comma separated list of lowercase names, e.g. " + default_reactions)
+		}
+	}
+	return reactions
+}
+
+// reactionsToAPI merges likes into reactions. Reactions which are no longer
+// allowed are dropped, the order is the order of allowed reactions.
+func (s *Service) reactionsToAPI(reactions storage.Reactions, likes int64, is_liked bool) *api.Reactions {
+	reactions_rsp := &api.Reactions{Counts: map[string]int64{}}
+	for _, reaction := range s.ReactionTypes {
+		count, mine := reactions.Counts[reaction], slices.Contains(reactions.Mine, reaction)
+		if reaction == like_reaction {
+			count, mine = likes, is_liked
+		}
+
+		if count > 0 {
+			reactions_rsp.Counts[reaction] = count
+		}
+		if mine {
+			reactions_rsp.Mine = append(reactions_rsp.Mine, reaction)
+		}
+	}
+	return reactions_rsp
+}
+
+// fillPostReactions sets reactions of posts, likes must be filled already.
+func (s *Service) fillPostReactions(ctx context.Context, posts []*api.Post, user_id int64) error {
+	post_ids := make([]uint, len(posts))
+	for i, post := range posts {
+		post_ids[i] = uint(post.Id)
+	}
+
+	reactions, err := s.Reactions.PostReactions(ctx, post_ids, user_id)
+	if err != nil {
+		return err
+	}
+
+	for i, post := range posts {
+		post.Reactions = s.reactionsToAPI(reactions[i], post.Likes, post.IsLiked)
+	}
+	return nil
+}
+
+// fillCommentReactions sets reactions of comments, likes must be filled already.
+func (s *Service) fillCommentReactions(ctx context.Context, comments []*api.Comment, user_id int64) error {
+	comment_ids := make([]uint, len(comments))
+	for i, comment := range comments {
+		comment_ids[i] = uint(comment.Id)
+	}
+
+	reactions, err := s.Reactions.CommentReactions(ctx, comment_ids, user_id)
+	if err != nil {
+		return err
+	}
+
+	for i, comment := range comments {
+		comment.Reactions = s.reactionsToAPI(reactions[i], comment.Likes, comment.IsLiked)
+	}
+	return nil
+}
+
+// reactionReq is implemented by ReactReq and UnreactReq.
+type reactionReq interface {
+	GetPostId() int64
+	GetCommentId() int64
+	GetReaction() string
+}
+
+func (s *Service) React(ctx context.Context, req *api.ReactReq) (*api.ReactRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.ReactRsp{}, err
+	}
+	log.Println("User:", user_id, "callded React")
+
+	reactions, err := s.changeReaction(ctx, req, user_id, true)
+	if err != nil {
+		return &api.ReactRsp{}, err
+	}
+	return &api.ReactRsp{Reactions: reactions}, nil
+}
+
+func (s *Service) Unreact(ctx context.Context, req *api.UnreactReq) (*api.UnreactRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.UnreactRsp{}, err
+	}
+	log.Println("User:", user_id, "callded Unreact")
+
+	reactions, err := s.changeReaction(ctx, req, user_id, false)
+	if err != nil {
+		return &api.UnreactRsp{}, err
+	}
+	return &api.UnreactRsp{Reactions: reactions}, nil
+}
+
+// changeReaction adds or removes reaction of the user to the post or the
+// comment and returns reactions of it after the change.
+func (s *Service) changeReaction(ctx context.Context, req reactionReq, user_id int64, add bool) (*api.Reactions, error) {
+	if (req.GetPostId() == 0) == (req.GetCommentId() == 0) {
+		return nil, errs.InvalidArgument("Exactly one of post_id and comment_id must be set!")
+	}
+	if !slices.Contains(s.ReactionTypes, req.GetReaction()) {
+		return nil, errs.InvalidArgument("Unknown reaction, expected one of " + strings.Join(s.ReactionTypes, ", ") + "!")
+	}
+
+	reaction := req.GetReaction()
+	s.Logger.Info("Reactions: start change reaction;", zap.Int64("post_id", req.GetPostId()), zap.Int64("comment_id", req.GetCommentId()),
+		zap.String("reaction", reaction), zap.Bool("add", add), zap.Int64("user_id", user_id))
+	defer s.Logger.Info("Reactions: ended change reaction;", zap.Int64("post_id", req.GetPostId()), zap.Int64("comment_id", req.GetCommentId()),
+		zap.String("reaction", reaction), zap.Bool("add", add), zap.Int64("user_id", user_id))
+
+	if req.GetPostId() != 0 {
+		post_id := uint(req.GetPostId())
+		if _, err := s.Posts.Get(ctx, post_id); errors.Is(err, storage.ErrNotFound) {
+			return nil, errs.NotFound("Post not found!")
+		} else if err != nil {
+			return nil, errs.Internal(err)
+		}
+
+		var changed bool
+		var err error
+		switch {
+		case reaction == like_reaction && add:
+			changed, err = s.Likes.LikePost(ctx, post_id, user_id)
+		case reaction == like_reaction:
+			changed, err = s.Likes.UnlikePost(ctx, post_id, user_id)
+		case add:
+			changed, err = s.Reactions.ReactPost(ctx, post_id, user_id, reaction)
+		default:
+			changed, err = s.Reactions.UnreactPost(ctx, post_id, user_id, reaction)
+		}
+		if err != nil {
+			return nil, errs.Internal(err)
+		}
+		if err := reactionConflict(changed, add); err != nil {
+			return nil, err
+		}
+
+		s.invalidatePostsCache(ctx)
+
+		likes, is_liked, err := s.Likes.PostLikes(ctx, post_id, user_id)
+		if err != nil {
+			return nil, errs.Internal(err)
+		}
+		reactions, err := s.Reactions.PostReactions(ctx, []uint{post_id}, user_id)
+		if err != nil {
+			return nil, errs.Internal(err)
+		}
+		return s.reactionsToAPI(reactions[0], likes, is_liked), nil
+	}
+
+	comment_id := uint(req.GetCommentId())
+	if _, err := s.Comments.Get(ctx, comment_id); errors.Is(err, storage.ErrNotFound) {
+		return nil, errs.NotFound("Comment not found!")
+	} else if err != nil {
+		return nil, errs.Internal(err)
+	}
+
+	var changed bool
+	var err error
+	switch {
+	case reaction == like_reaction && add:
+		changed, err = s.Likes.LikeComment(ctx, comment_id, user_id)
+	case reaction == like_reaction:
+		changed, err = s.Likes.UnlikeComment(ctx, comment_id, user_id)
+	case add:
+		changed, err = s.Reactions.ReactComment(ctx, comment_id, user_id, reaction)
+	default:
+		changed, err = s.Reactions.UnreactComment(ctx, comment_id, user_id, reaction)
+	}
+	if err != nil {
+		return nil, errs.Internal(err)
+	}
+	if err := reactionConflict(changed, add); err != nil {
+		return nil, err
+	}
+
+	likes, is_liked, err := s.Likes.CommentLikes(ctx, comment_id, user_id)
+	if err != nil {
+		return nil, errs.Internal(err)
+	}
+	reactions, err := s.Reactions.CommentReactions(ctx, []uint{comment_id}, user_id)
+	if err != nil {
+		return nil, errs.Internal(err)
+	}
+	return s.reactionsToAPI(reactions[0], likes, is_liked), nil
+}
+
+func reactionConflict(changed bool, add bool) error {
+	switch {
+	case changed:
+		return nil
+	case add:
+		return errs.Conflict("You already left this reaction!")
+	default:
+		return errs.Conflict("You did not leave this reaction!")
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/storage"
+)
+
+func hitIDs(hits []storage.SearchHit) []uint {
+	ids := make([]uint, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
+
+func hitsByID(hits []storage.SearchHit) map[int64]storage.SearchHit {
+	by_id := make(map[int64]storage.SearchHit, len(hits))
+	for _, hit := range hits {
+		by_id[int64(hit.ID)] = hit
+	}
+	return by_id
+}
+
+func (s *Service) SearchPosts(ctx context.Context, req *api.SearchPostsReq) (*api.SearchPostsRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded SearchPosts")
+
+	hits, err := s.Posts.Search(ctx, storage.SearchQuery{
+		Query:    req.Query,
+		AuthorID: uint(req.AuthorId),
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return &api.SearchPostsRsp{}, errs.Internal(err)
+	}
+
+	posts, err := s.Posts.FindByIDs(ctx, hitIDs(hits))
+	if err != nil {
+		return &api.SearchPostsRsp{}, errs.Internal(err)
+	}
+	hits_by_id := hitsByID(hits)
+
+	posts_rsp, err := s.postsToAPI(ctx, posts, user_id)
+	if err != nil {
+		return &api.SearchPostsRsp{}, errs.Internal(err)
+	}
+
+	results := make([]*api.PostSearchResult, len(posts_rsp))
+	for i, post := range posts_rsp {
+		results[i] = &api.PostSearchResult{
+			Post:         post,
+			Rank:         hits_by_id[post.Id].Rank,
+			TitleSnippet: hits_by_id[post.Id].TitleSnippet,
+			BodySnippet:  hits_by_id[post.Id].BodySnippet,
+		}
+	}
+
+	return &api.SearchPostsRsp{Results: results}, nil
+}
+
+func (s *Service) SearchComments(ctx context.Context, req *api.SearchCommentsReq) (*api.SearchCommentsRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded SearchComments")
+
+	hits, err := s.Comments.Search(ctx, storage.SearchQuery{
+		Query:    req.Query,
+		AuthorID: uint(req.AuthorId),
+		PostID:   uint(req.PostId),
+		Offset:   req.Offset,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return &api.SearchCommentsRsp{}, errs.Internal(err)
+	}
+
+	comments, err := s.Comments.FindByIDs(ctx, hitIDs(hits))
+	if err != nil {
+		return &api.SearchCommentsRsp{}, errs.Internal(err)
+	}
+	hits_by_id := hitsByID(hits)
+
+	comments_rsp, err := s.commentsToAPI(ctx, comments, user_id)
+	if err != nil {
+		return &api.SearchCommentsRsp{}, errs.Internal(err)
+	}
+
+	results := make([]*api.CommentSearchResult, len(comments_rsp))
+	for i, comment := range comments_rsp {
+		results[i] = &api.CommentSearchResult{
+			Comment: comment,
+			Rank:    hits_by_id[comment.Id].Rank,
+			Snippet: hits_by_id[comment.Id].BodySnippet,
+		}
+	}
+
+	return &api.SearchCommentsRsp{Results: results}, nil
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"go.uber.org/zap"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+const refresh_token_ttl = 30 * 24 * time.Hour
+
+// issueTokens signs access token and stores new refresh token.
+func (s *Service) issueTokens(ctx context.Context, user_id int64) (*api.Tokens, error) {
+	access_token, _, err := s.Tokens.Issue(user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh_token, err := auth.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger.Info("Sessions: start save refresh token;", zap.Int64("user_id", user_id))
+	err = s.Sessions.SaveRefreshToken(ctx, refresh_token, user_id, refresh_token_ttl)
+	s.Logger.Info("Sessions: ended save refresh token;", zap.Int64("user_id", user_id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Tokens{
+		AccessToken:  access_token,
+		RefreshToken: refresh_token,
+		ExpiresIn:    int64(s.Tokens.TTL().Seconds()),
+	}, nil
+}
+
+func (s *Service) Register(ctx context.Context, req *api.RegisterReq) (*api.RegisterRsp, error) {
+	log.Println("Login:", req.Login, "callded Register")
+
+	hash, salt, err := auth.HashPassword(req.Password)
+	if err != nil {
+		return &api.RegisterRsp{}, errs.Internal(err)
+	}
+
+	user := models.User{Name: req.Name}
+	credentials := models.Credentials{Login: req.Login, PasswordHash: hash, Salt: salt}
+	err = s.Users.Create(ctx, &user, &credentials)
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		return &api.RegisterRsp{}, errs.Conflict("Login is already taken!")
+	} else if err != nil {
+		return &api.RegisterRsp{}, errs.Internal(err)
+	}
+
+	tokens, err := s.issueTokens(ctx, int64(user.ID))
+	if err != nil {
+		return &api.RegisterRsp{}, errs.Internal(err)
+	}
+
+	return &api.RegisterRsp{
+		User:   &api.UserInfo{Id: int64(user.ID), Name: user.Name},
+		Tokens: tokens,
+	}, nil
+}
+
+func (s *Service) Login(ctx context.Context, req *api.LoginReq) (*api.LoginRsp, error) {
+	log.Println("Login:", req.Login, "callded Login")
+
+	credentials, err := s.Users.GetCredentials(ctx, req.Login)
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.LoginRsp{}, errs.Unauthenticated("Wrong login or password!")
+	} else if err != nil {
+		return &api.LoginRsp{}, errs.Internal(err)
+	}
+
+	if !auth.CheckPassword(req.Password, credentials.PasswordHash, credentials.Salt) {
+		return &api.LoginRsp{}, errs.Unauthenticated("Wrong login or password!")
+	}
+
+	tokens, err := s.issueTokens(ctx, int64(credentials.UserID))
+	if err != nil {
+		return &api.LoginRsp{}, errs.Internal(err)
+	}
+
+	return &api.LoginRsp{
+		User:   &api.UserInfo{Id: int64(credentials.User.ID), Name: credentials.User.Name},
+		Tokens: tokens,
+	}, nil
+}
+
+// Logout revokes refresh token of the caller, sessions of other users can not
+// be revoked. Unknown token is not an error, it is revoked already.
+func (s *Service) Logout(ctx context.Context, req *api.LogoutReq) (*api.LogoutRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.LogoutRsp{}, err
+	}
+	log.Println("User:", user_id, "callded Logout")
+
+	owner_id, err := s.Sessions.RefreshTokenUser(ctx, req.RefreshToken)
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.LogoutRsp{}, nil
+	} else if err != nil {
+		return &api.LogoutRsp{}, errs.Internal(err)
+	}
+
+	if owner_id != user_id {
+		return &api.LogoutRsp{}, errs.PermissionDenied("It is not your session!")
+	}
+
+	s.Logger.Info("Sessions: start delete refresh token;")
+	err = s.Sessions.DeleteRefreshToken(ctx, req.RefreshToken)
+	s.Logger.Info("Sessions: ended delete refresh token;")
+	if err != nil {
+		return &api.LogoutRsp{}, errs.Internal(err)
+	}
+
+	return &api.LogoutRsp{}, nil
+}
+
+func (s *Service) RefreshToken(ctx context.Context, req *api.RefreshTokenReq) (*api.RefreshTokenRsp, error) {
+	log.Println("User:", auth.UserID(ctx), "callded RefreshToken")
+
+	// refresh token is single use: it is rotated on every refresh
+	s.Logger.Info("Sessions: start take refresh token;")
+	user_id, err := s.Sessions.TakeRefreshToken(ctx, req.RefreshToken)
+	s.Logger.Info("Sessions: ended take refresh token;")
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.RefreshTokenRsp{}, errs.Unauthenticated("Refresh token is invalid or expired!")
+	} else if err != nil {
+		return &api.RefreshTokenRsp{}, errs.Internal(err)
+	}
+
+	tokens, err := s.issueTokens(ctx, user_id)
+	if err != nil {
+		return &api.RefreshTokenRsp{}, errs.Internal(err)
+	}
+
+	return &api.RefreshTokenRsp{Tokens: tokens}, nil
+}
@@ -0,0 +1,288 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/pagination"
+	"go_1C/storage"
+)
+
+type CommentRepository struct {
+	db *DB
+}
+
+func NewCommentRepository(db *DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func stripComment(comment models.Comment) models.Comment {
+	comment.Author = models.User{}
+	return comment
+}
+
+func sortComments(comments []models.Comment) {
+	sort.Slice(comments, func(i, j int) bool {
+		return createdBefore(comments[i].CreatedAt, comments[i].ID, comments[j].CreatedAt, comments[j].ID)
+	})
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment *models.Comment) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.users[comment.AuthorID]; !ok {
+		return storage.ErrNotFound
+	}
+	if _, ok := r.db.posts[comment.PostRefer]; !ok {
+		return storage.ErrNotFound
+	}
+
+	comment.ID = r.db.nextID("comments")
+	comment.CreatedAt = now()
+	comment.UpdatedAt = comment.CreatedAt
+	r.db.comments[comment.ID] = stripComment(*comment)
+	r.db.countComments(comment.PostRefer, 1)
+	*comment = r.db.comment(*comment)
+	return nil
+}
+
+func (r *CommentRepository) Get(ctx context.Context, id uint) (*models.Comment, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	comment, ok := r.db.comments[id]
+	if !ok || comment.DeletedAt.Valid {
+		return nil, storage.ErrNotFound
+	}
+	comment = r.db.comment(comment)
+	return &comment, nil
+}
+
+func (r *CommentRepository) GetUnscoped(ctx context.Context, id uint) (*models.Comment, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	comment, ok := r.db.comments[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	comment = r.db.comment(comment)
+	return &comment, nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, comment *models.Comment, revision *models.CommentRevision) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.comments[comment.ID]; !ok {
+		return storage.ErrNotFound
+	}
+
+	revision.ID = r.db.nextID("comment_revisions")
+	revision.CreatedAt = now()
+	r.db.commentRevisions = append(r.db.commentRevisions, *revision)
+
+	comment.UpdatedAt = now()
+	r.db.comments[comment.ID] = stripComment(*comment)
+	return nil
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, id uint) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if comment, ok := r.db.comments[id]; ok && !comment.DeletedAt.Valid {
+		comment.DeletedAt = gorm.DeletedAt{Time: now(), Valid: true}
+		r.db.comments[id] = comment
+		r.db.countComments(comment.PostRefer, -1)
+	}
+	return nil
+}
+
+func (r *CommentRepository) Restore(ctx context.Context, id uint) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if comment, ok := r.db.comments[id]; ok && comment.DeletedAt.Valid {
+		comment.DeletedAt = gorm.DeletedAt{}
+		r.db.comments[id] = comment
+		r.db.countComments(comment.PostRefer, 1)
+	}
+	return nil
+}
+
+func (r *CommentRepository) ListByPost(ctx context.Context, post_id uint, cursor *pagination.Cursor, offset int64, limit int64) ([]models.Comment, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	comments := []models.Comment{}
+	for _, comment := range r.db.comments {
+		if comment.PostRefer != post_id || comment.DeletedAt.Valid || !r.db.livePost(comment) {
+			continue
+		}
+		if cursor != nil && !createdBefore(cursor.CreatedAt, uint(cursor.ID), comment.CreatedAt, comment.ID) {
+			continue
+		}
+		comments = append(comments, r.db.comment(comment))
+	}
+	sortComments(comments)
+
+	if cursor != nil {
+		return page(comments, 0, limit), nil
+	}
+	return page(comments, offset, limit), nil
+}
+
+func (r *CommentRepository) ListByAuthor(ctx context.Context, author_id uint, offset int64, limit int64) ([]models.Comment, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	comments := []models.Comment{}
+	for _, comment := range r.db.comments {
+		if comment.AuthorID == author_id && !comment.DeletedAt.Valid && r.db.livePost(comment) {
+			comments = append(comments, r.db.comment(comment))
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].ID > comments[j].ID
+	})
+	return page(comments, offset, limit), nil
+}
+
+func (r *CommentRepository) ListThread(ctx context.Context, post_id uint, parent_id *uint, offset int64, limit int64) ([]models.Comment, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	comments := []models.Comment{}
+	for _, comment := range r.db.comments {
+		if parent_id != nil && (comment.ParentID == nil || *comment.ParentID != *parent_id) {
+			continue
+		}
+		if parent_id == nil && (comment.PostRefer != post_id || comment.ParentID != nil) {
+			continue
+		}
+		if comment.DeletedAt.Valid && !r.db.hasReplies(comment.ID) || !r.db.livePost(comment) {
+			continue
+		}
+		comments = append(comments, r.db.comment(comment))
+	}
+	sortComments(comments)
+	return page(comments, offset, limit), nil
+}
+
+func (r *CommentRepository) ListReplies(ctx context.Context, parent_ids []uint, limit int64) ([]models.Comment, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	parents := make(map[uint]bool, len(parent_ids))
+	for _, parent_id := range parent_ids {
+		parents[parent_id] = true
+	}
+
+	by_parent := map[uint][]models.Comment{}
+	for _, comment := range r.db.comments {
+		if comment.ParentID == nil || !parents[*comment.ParentID] {
+			continue
+		}
+		if comment.DeletedAt.Valid && !r.db.hasReplies(comment.ID) {
+			continue
+		}
+		by_parent[*comment.ParentID] = append(by_parent[*comment.ParentID], r.db.comment(comment))
+	}
+
+	replies := []models.Comment{}
+	for _, comments := range by_parent {
+		sortComments(comments)
+		replies = append(replies, page(comments, 0, limit)...)
+	}
+	sortComments(replies)
+	return replies, nil
+}
+
+func (r *CommentRepository) ReplyCounts(ctx context.Context, ids []uint) (map[uint]int64, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	parents := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		parents[id] = true
+	}
+
+	by_parent := make(map[uint]int64, len(ids))
+	for _, comment := range r.db.comments {
+		if comment.ParentID != nil && parents[*comment.ParentID] && !comment.DeletedAt.Valid {
+			by_parent[*comment.ParentID]++
+		}
+	}
+	return by_parent, nil
+}
+
+func (r *CommentRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Comment, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	comments := make([]models.Comment, 0, len(ids))
+	for _, id := range ids {
+		if comment, ok := r.db.comments[id]; ok && !comment.DeletedAt.Valid {
+			comments = append(comments, r.db.comment(comment))
+		}
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) ExistingIDs(ctx context.Context, ids []uint) ([]uint, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	existing := []uint{}
+	for _, id := range ids {
+		if _, ok := r.db.comments[id]; ok {
+			existing = append(existing, id)
+		}
+	}
+	return existing, nil
+}
+
+func (r *CommentRepository) Revisions(ctx context.Context, comment_id uint, offset int64, limit int64) ([]models.CommentRevision, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	// revisions are appended in id order
+	revisions := []models.CommentRevision{}
+	for i := len(r.db.commentRevisions) - 1; i >= 0; i-- {
+		if r.db.commentRevisions[i].CommentID == comment_id {
+			revisions = append(revisions, r.db.commentRevisions[i])
+		}
+	}
+	return page(revisions, offset, limit), nil
+}
+
+func (r *CommentRepository) Search(ctx context.Context, q storage.SearchQuery) ([]storage.SearchHit, error) {
+	terms := searchTerms(q.Query)
+
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	hits := []storage.SearchHit{}
+	for _, comment := range r.db.comments {
+		if comment.DeletedAt.Valid || !r.db.livePost(comment) || !matchesAll(terms, comment.Body) {
+			continue
+		}
+		if (q.AuthorID != 0 && comment.AuthorID != q.AuthorID) || (q.PostID != 0 && comment.PostRefer != q.PostID) {
+			continue
+		}
+
+		hits = append(hits, storage.SearchHit{
+			ID:          comment.ID,
+			Rank:        float32(countMatches(comment.Body, terms)),
+			BodySnippet: highlight(comment.Body, terms),
+		})
+	}
+
+	sortHits(hits)
+	return page(hits, q.Offset, q.Limit), nil
+}
@@ -0,0 +1,122 @@
+// Package memory implements stores in process memory, so the service can run
+// and be tested without postgres and redis.
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+type follow struct {
+	followerID uint
+	followeeID uint
+}
+
+// DB holds tables shared by repositories, the same way postgres does:
+// posts are returned with their authors and comments, feed reads follows.
+// Rows are stored without associations and copied on every read.
+type DB struct {
+	mu               sync.RWMutex
+	lastID           map[string]uint
+	users            map[uint]models.User
+	credentials      map[string]models.Credentials
+	follows          map[follow]time.Time
+	posts            map[uint]models.Post
+	comments         map[uint]models.Comment
+	postRevisions    []models.PostRevision
+	commentRevisions []models.CommentRevision
+	outbox           []models.OutboxEvent
+	deadEvents       []models.OutboxEvent
+}
+
+func NewDB() *DB {
+	return &DB{
+		lastID:      map[string]uint{},
+		users:       map[uint]models.User{},
+		credentials: map[string]models.Credentials{},
+		follows:     map[follow]time.Time{},
+		posts:       map[uint]models.Post{},
+		comments:    map[uint]models.Comment{},
+	}
+}
+
+// now is truncated to microseconds as postgres does, page tokens rely on it.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+func (db *DB) nextID(table string) uint {
+	db.lastID[table]++
+	return db.lastID[table]
+}
+
+func (db *DB) post(post models.Post) models.Post {
+	post.Author = db.users[post.AuthorID]
+	return post
+}
+
+// countComments changes number of not deleted comments of the post, as
+// postgres keeps comments_count.
+func (db *DB) countComments(post_id uint, delta int64) {
+	if post, ok := db.posts[post_id]; ok {
+		post.CommentsCount += delta
+		db.posts[post_id] = post
+	}
+}
+
+func (db *DB) comment(comment models.Comment) models.Comment {
+	comment.Author = db.users[comment.AuthorID]
+	return comment
+}
+
+// livePost reports whether the post of the comment is not deleted. Comments
+// of soft deleted post are kept for its restore, but not listed.
+func (db *DB) livePost(comment models.Comment) bool {
+	post, ok := db.posts[comment.PostRefer]
+	return ok && !post.DeletedAt.Valid
+}
+
+// hasReplies reports whether the comment has replies, deleted ones included.
+func (db *DB) hasReplies(id uint) bool {
+	for _, comment := range db.comments {
+		if comment.ParentID != nil && *comment.ParentID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// createdBefore orders rows by (created_at, id).
+func createdBefore(a_created_at time.Time, a_id uint, b_created_at time.Time, b_id uint) bool {
+	if !a_created_at.Equal(b_created_at) {
+		return a_created_at.Before(b_created_at)
+	}
+	return a_id < b_id
+}
+
+// page cuts rows the same way OFFSET and LIMIT do, negative limit means no limit.
+func page[T any](rows []T, offset int64, limit int64) []T {
+	if offset < 0 {
+		offset = 0
+	}
+	rows = rows[min(offset, int64(len(rows))):]
+	if limit >= 0 {
+		rows = rows[:min(limit, int64(len(rows)))]
+	}
+	return rows
+}
+
+var (
+	_ storage.PostRepository    = (*PostRepository)(nil)
+	_ storage.CommentRepository = (*CommentRepository)(nil)
+	_ storage.UserRepository    = (*UserRepository)(nil)
+	_ storage.LikeStore         = (*LikeStore)(nil)
+	_ storage.ReactionStore     = (*ReactionStore)(nil)
+	_ storage.VoteStore         = (*VoteStore)(nil)
+	_ storage.SessionStore      = (*SessionStore)(nil)
+	_ storage.FeedStore         = (*FeedStore)(nil)
+	_ storage.Outbox            = (*Outbox)(nil)
+)
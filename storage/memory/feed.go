@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"go_1C/models"
+	"go_1C/pagination"
+)
+
+// FeedStore reads feed straight from DB, there are no timelines to maintain.
+type FeedStore struct {
+	db *DB
+}
+
+func NewFeedStore(db *DB) *FeedStore {
+	return &FeedStore{db: db}
+}
+
+func (s *FeedStore) AddPost(ctx context.Context, post *models.Post) error {
+	return nil
+}
+
+func (s *FeedStore) RemovePost(ctx context.Context, post *models.Post) error {
+	return nil
+}
+
+func (s *FeedStore) Feed(ctx context.Context, user_id uint, cursor *pagination.Cursor, limit int64) ([]models.Post, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	followees := map[uint]bool{}
+	for f := range s.db.follows {
+		if f.followerID == user_id {
+			followees[f.followeeID] = true
+		}
+	}
+
+	posts := []models.Post{}
+	for _, post := range s.db.posts {
+		if post.DeletedAt.Valid || !followees[post.AuthorID] {
+			continue
+		}
+		if cursor != nil && !createdBefore(post.CreatedAt, post.ID, cursor.CreatedAt, uint(cursor.ID)) {
+			continue
+		}
+		posts = append(posts, s.db.post(post))
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return createdBefore(posts[j].CreatedAt, posts[j].ID, posts[i].CreatedAt, posts[i].ID)
+	})
+
+	return page(posts, 0, limit), nil
+}
+
+func (s *FeedStore) Reset(ctx context.Context, user_id uint) error {
+	return nil
+}
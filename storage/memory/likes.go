@@ -0,0 +1,234 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go_1C/storage"
+)
+
+// likes keeps time of every like, likers are listed the latest first.
+type likes map[uint]map[int64]time.Time
+
+func (l likes) has(id uint, user_id int64) bool {
+	_, ok := l[id][user_id]
+	return ok
+}
+
+func (l likes) add(id uint, user_id int64) bool {
+	if l.has(id, user_id) {
+		return false
+	}
+	if l[id] == nil {
+		l[id] = map[int64]time.Time{}
+	}
+	l[id][user_id] = time.Now()
+	return true
+}
+
+func (l likes) remove(id uint, user_id int64) bool {
+	if !l.has(id, user_id) {
+		return false
+	}
+	delete(l[id], user_id)
+	return true
+}
+
+func (l likes) of(ids []uint, user_id int64) []storage.Likes {
+	result := make([]storage.Likes, len(ids))
+	for i, id := range ids {
+		result[i] = storage.Likes{Count: int64(len(l[id])), Liked: l.has(id, user_id)}
+	}
+	return result
+}
+
+// likers returns users who liked, the latest like first.
+func (l likes) likers(id uint) []uint {
+	likers := make([]uint, 0, len(l[id]))
+	for user_id := range l[id] {
+		likers = append(likers, uint(user_id))
+	}
+	sort.Slice(likers, func(i, j int) bool {
+		liked_i, liked_j := l[id][int64(likers[i])], l[id][int64(likers[j])]
+		if !liked_i.Equal(liked_j) {
+			return liked_i.After(liked_j)
+		}
+		return likers[i] > likers[j]
+	})
+	return likers
+}
+
+// ids returns ids having likes.
+func (l likes) ids() []uint {
+	ids := []uint{}
+	for id, users := range l {
+		if len(users) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// LikeStore keeps likes apart from DB. Nothing is cached, the store is the
+// source of truth and the cache at once. Likes counters of posts are copied
+// to DB, as postgres keeps likes_count, so posts can be ordered by likes.
+type LikeStore struct {
+	db       *DB
+	mu       sync.RWMutex
+	posts    likes
+	comments likes
+}
+
+func NewLikeStore(db *DB) *LikeStore {
+	return &LikeStore{db: db, posts: likes{}, comments: likes{}}
+}
+
+// countPostLikes copies likes counter of the post to DB, s.mu must be held.
+func (s *LikeStore) countPostLikes(post_id uint) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if post, ok := s.db.posts[post_id]; ok {
+		post.LikesCount = int64(len(s.posts[post_id]))
+		s.db.posts[post_id] = post
+	}
+}
+
+func (s *LikeStore) LikePost(ctx context.Context, post_id uint, user_id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	liked := s.posts.add(post_id, user_id)
+	if liked {
+		s.countPostLikes(post_id)
+	}
+	return liked, nil
+}
+
+func (s *LikeStore) UnlikePost(ctx context.Context, post_id uint, user_id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unliked := s.posts.remove(post_id, user_id)
+	if unliked {
+		s.countPostLikes(post_id)
+	}
+	return unliked, nil
+}
+
+func (s *LikeStore) PostLikes(ctx context.Context, post_id uint, user_id int64) (int64, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return int64(len(s.posts[post_id])), s.posts.has(post_id, user_id), nil
+}
+
+func (s *LikeStore) PostsLikes(ctx context.Context, post_ids []uint, user_id int64) ([]storage.Likes, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.posts.of(post_ids, user_id), nil
+}
+
+func (s *LikeStore) LikedPosts(ctx context.Context, post_ids []uint, user_id int64) ([]bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	liked := make([]bool, len(post_ids))
+	for i, post_id := range post_ids {
+		liked[i] = s.posts.has(post_id, user_id)
+	}
+	return liked, nil
+}
+
+func (s *LikeStore) PostLikers(ctx context.Context, post_id uint, offset int64, limit int64) ([]uint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return page(s.posts.likers(post_id), offset, limit), nil
+}
+
+func (s *LikeStore) LatestPostLikers(ctx context.Context, post_ids []uint, user_ids []uint) ([]uint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	latest := make([]uint, len(post_ids))
+	for i, post_id := range post_ids {
+		var liked_at time.Time
+		for _, user_id := range user_ids {
+			at, ok := s.posts[post_id][int64(user_id)]
+			if ok && (latest[i] == 0 || at.After(liked_at) || at.Equal(liked_at) && user_id > latest[i]) {
+				latest[i], liked_at = user_id, at
+			}
+		}
+	}
+	return latest, nil
+}
+
+func (s *LikeStore) RefreshPostLikes(ctx context.Context, post_id uint) error {
+	return nil
+}
+
+func (s *LikeStore) RefreshCommentLikes(ctx context.Context, comment_id uint) error {
+	return nil
+}
+
+func (s *LikeStore) LikedPostIDs(ctx context.Context) ([]uint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.posts.ids(), nil
+}
+
+func (s *LikeStore) LikedCommentIDs(ctx context.Context) ([]uint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.comments.ids(), nil
+}
+
+func (s *LikeStore) DeletePostLikes(ctx context.Context, post_ids []uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, post_id := range post_ids {
+		delete(s.posts, post_id)
+		s.countPostLikes(post_id)
+	}
+	return nil
+}
+
+func (s *LikeStore) DeleteCommentLikes(ctx context.Context, comment_ids []uint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, comment_id := range comment_ids {
+		delete(s.comments, comment_id)
+	}
+	return nil
+}
+
+func (s *LikeStore) LikeComment(ctx context.Context, comment_id uint, user_id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.comments.add(comment_id, user_id), nil
+}
+
+func (s *LikeStore) UnlikeComment(ctx context.Context, comment_id uint, user_id int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.comments.remove(comment_id, user_id), nil
+}
+
+func (s *LikeStore) CommentLikes(ctx context.Context, comment_id uint, user_id int64) (int64, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return int64(len(s.comments[comment_id])), s.comments.has(comment_id, user_id), nil
+}
+
+func (s *LikeStore) CommentLikers(ctx context.Context, comment_id uint, offset int64, limit int64) ([]uint, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return page(s.comments.likers(comment_id), offset, limit), nil
+}
+
+func (s *LikeStore) CommentsLikes(ctx context.Context, comment_ids []uint, user_id int64) ([]storage.Likes, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.comments.of(comment_ids, user_id), nil
+}
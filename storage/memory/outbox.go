@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+// addEvent saves outbox event, db.mu must be held for writing.
+func (db *DB) addEvent(kind string, entity_id uint) {
+	db.outbox = append(db.outbox, models.OutboxEvent{
+		ID:        db.nextID("outbox_events"),
+		Kind:      kind,
+		EntityID:  entity_id,
+		CreatedAt: now(),
+	})
+}
+
+type Outbox struct {
+	db *DB
+}
+
+func NewOutbox(db *DB) *Outbox {
+	return &Outbox{db: db}
+}
+
+// Process applies events without holding the lock, so apply may use other
+// stores. Events are only removed after they are applied, so Process must
+// not be called concurrently.
+func (o *Outbox) Process(ctx context.Context, limit int, apply func(event models.OutboxEvent) error) (int, error) {
+	o.db.mu.RLock()
+	events := make([]models.OutboxEvent, min(limit, len(o.db.outbox)))
+	copy(events, o.db.outbox)
+	o.db.mu.RUnlock()
+
+	applied := 0
+	var apply_err error
+	for _, event := range events {
+		if apply_err = apply(event); apply_err != nil {
+			break
+		}
+		applied++
+	}
+
+	o.db.mu.Lock()
+	defer o.db.mu.Unlock()
+
+	// events are only appended meanwhile, so applied ones are still first
+	o.db.outbox = o.db.outbox[applied:]
+	if apply_err != nil {
+		failed := &o.db.outbox[0]
+		failed.Attempts++
+		failed.LastError = apply_err.Error()
+		if failed.Attempts >= storage.MaxEventAttempts {
+			dead_at := now()
+			failed.DeadAt = &dead_at
+			o.db.deadEvents = append(o.db.deadEvents, *failed)
+			apply_err = &storage.DeadEventError{Event: *failed, Err: apply_err}
+			o.db.outbox = o.db.outbox[1:]
+		}
+	}
+	return applied, apply_err
+}
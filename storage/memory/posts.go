@@ -0,0 +1,285 @@
+package memory
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/pagination"
+	"go_1C/storage"
+)
+
+type PostRepository struct {
+	db *DB
+}
+
+func NewPostRepository(db *DB) *PostRepository {
+	return &PostRepository{db: db}
+}
+
+// stripPost drops associations, they are loaded from their own tables on read.
+func stripPost(post models.Post) models.Post {
+	post.Author = models.User{}
+	post.Comments = nil
+	return post
+}
+
+func matchPost(post *models.Post, filter storage.PostFilter) bool {
+	if post.DeletedAt.Valid {
+		return false
+	}
+	if filter.AuthorID != 0 && post.AuthorID != filter.AuthorID {
+		return false
+	}
+	if filter.CreatedAfter != nil && post.CreatedAt.Before(*filter.CreatedAfter) {
+		return false
+	}
+	if filter.CreatedBefore != nil && !post.CreatedAt.Before(*filter.CreatedBefore) {
+		return false
+	}
+	return true
+}
+
+func (r *PostRepository) Create(ctx context.Context, post *models.Post) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.users[post.AuthorID]; !ok {
+		return storage.ErrNotFound
+	}
+
+	post.ID = r.db.nextID("posts")
+	post.CreatedAt = now()
+	post.UpdatedAt = post.CreatedAt
+	r.db.posts[post.ID] = stripPost(*post)
+	r.db.addEvent(storage.EventPostCreated, post.ID)
+	*post = r.db.post(*post)
+	return nil
+}
+
+func (r *PostRepository) Get(ctx context.Context, id uint) (*models.Post, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	post, ok := r.db.posts[id]
+	if !ok || post.DeletedAt.Valid {
+		return nil, storage.ErrNotFound
+	}
+	post = r.db.post(post)
+	return &post, nil
+}
+
+func (r *PostRepository) GetUnscoped(ctx context.Context, id uint) (*models.Post, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	post, ok := r.db.posts[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	post = r.db.post(post)
+	return &post, nil
+}
+
+func (r *PostRepository) Update(ctx context.Context, post *models.Post, revision *models.PostRevision) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	stored, ok := r.db.posts[post.ID]
+	if !ok {
+		return storage.ErrNotFound
+	}
+
+	revision.ID = r.db.nextID("post_revisions")
+	revision.CreatedAt = now()
+	r.db.postRevisions = append(r.db.postRevisions, *revision)
+
+	// counters are kept by their stores, as postgres never writes them here
+	post.LikesCount, post.CommentsCount = stored.LikesCount, stored.CommentsCount
+	post.Upvotes, post.Downvotes = stored.Upvotes, stored.Downvotes
+	post.UpdatedAt = now()
+	r.db.posts[post.ID] = stripPost(*post)
+	return nil
+}
+
+func (r *PostRepository) Delete(ctx context.Context, id uint) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if post, ok := r.db.posts[id]; ok && !post.DeletedAt.Valid {
+		post.DeletedAt = gorm.DeletedAt{Time: now(), Valid: true}
+		r.db.posts[id] = post
+		r.db.addEvent(storage.EventPostDeleted, id)
+	}
+	return nil
+}
+
+func (r *PostRepository) Restore(ctx context.Context, id uint) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if post, ok := r.db.posts[id]; ok && post.DeletedAt.Valid {
+		post.DeletedAt = gorm.DeletedAt{}
+		r.db.posts[id] = post
+		r.db.addEvent(storage.EventPostRestored, id)
+	}
+	return nil
+}
+
+func (r *PostRepository) Find(ctx context.Context, q storage.PostQuery) ([]models.Post, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	posts := []models.Post{}
+	for _, post := range r.db.posts {
+		if matchPost(&post, q.PostFilter) {
+			posts = append(posts, r.db.post(post))
+		}
+	}
+
+	switch q.Order {
+	case storage.PostsNewest:
+		sort.Slice(posts, func(i, j int) bool {
+			return createdBefore(posts[j].CreatedAt, posts[j].ID, posts[i].CreatedAt, posts[i].ID)
+		})
+		if q.Cursor != nil {
+			posts = cutBefore(posts, q.Cursor)
+		}
+	case storage.PostsMostCommented:
+		sort.Slice(posts, func(i, j int) bool {
+			if posts[i].CommentsCount != posts[j].CommentsCount {
+				return posts[i].CommentsCount > posts[j].CommentsCount
+			}
+			return posts[i].ID > posts[j].ID
+		})
+	case storage.PostsTopLiked:
+		sort.Slice(posts, func(i, j int) bool {
+			if posts[i].LikesCount != posts[j].LikesCount {
+				return posts[i].LikesCount > posts[j].LikesCount
+			}
+			return posts[i].ID > posts[j].ID
+		})
+	case storage.PostsHot:
+		scores := make(map[uint]float64, len(posts))
+		at := now()
+		for _, post := range posts {
+			age_hours := at.Sub(post.CreatedAt).Hours()
+			scores[post.ID] = float64(post.LikesCount) / math.Pow(max(age_hours, 0)+2, storage.HotGravity)
+		}
+		sort.Slice(posts, func(i, j int) bool {
+			if scores[posts[i].ID] != scores[posts[j].ID] {
+				return scores[posts[i].ID] > scores[posts[j].ID]
+			}
+			return posts[i].ID > posts[j].ID
+		})
+	case storage.PostsTopScore:
+		sort.Slice(posts, func(i, j int) bool {
+			score_i, score_j := posts[i].Upvotes-posts[i].Downvotes, posts[j].Upvotes-posts[j].Downvotes
+			if score_i != score_j {
+				return score_i > score_j
+			}
+			return createdBefore(posts[j].CreatedAt, posts[j].ID, posts[i].CreatedAt, posts[i].ID)
+		})
+	default:
+		sort.Slice(posts, func(i, j int) bool {
+			return createdBefore(posts[i].CreatedAt, posts[i].ID, posts[j].CreatedAt, posts[j].ID)
+		})
+		if q.Cursor != nil {
+			posts = cutAfter(posts, q.Cursor)
+		}
+	}
+
+	if q.Cursor != nil {
+		return page(posts, 0, q.Limit), nil
+	}
+	return page(posts, q.Offset, q.Limit), nil
+}
+
+// cutAfter keeps posts created after the cursor.
+func cutAfter(posts []models.Post, cursor *pagination.Cursor) []models.Post {
+	kept := []models.Post{}
+	for _, post := range posts {
+		if createdBefore(cursor.CreatedAt, uint(cursor.ID), post.CreatedAt, post.ID) {
+			kept = append(kept, post)
+		}
+	}
+	return kept
+}
+
+// cutBefore keeps posts created before the cursor.
+func cutBefore(posts []models.Post, cursor *pagination.Cursor) []models.Post {
+	kept := []models.Post{}
+	for _, post := range posts {
+		if createdBefore(post.CreatedAt, post.ID, cursor.CreatedAt, uint(cursor.ID)) {
+			kept = append(kept, post)
+		}
+	}
+	return kept
+}
+
+func (r *PostRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Post, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	posts := make([]models.Post, 0, len(ids))
+	for _, id := range ids {
+		if post, ok := r.db.posts[id]; ok && !post.DeletedAt.Valid {
+			posts = append(posts, r.db.post(post))
+		}
+	}
+	return posts, nil
+}
+
+func (r *PostRepository) ExistingIDs(ctx context.Context, ids []uint) ([]uint, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	existing := []uint{}
+	for _, id := range ids {
+		if _, ok := r.db.posts[id]; ok {
+			existing = append(existing, id)
+		}
+	}
+	return existing, nil
+}
+
+func (r *PostRepository) Revisions(ctx context.Context, post_id uint, offset int64, limit int64) ([]models.PostRevision, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	// revisions are appended in id order
+	revisions := []models.PostRevision{}
+	for i := len(r.db.postRevisions) - 1; i >= 0; i-- {
+		if r.db.postRevisions[i].PostID == post_id {
+			revisions = append(revisions, r.db.postRevisions[i])
+		}
+	}
+	return page(revisions, offset, limit), nil
+}
+
+func (r *PostRepository) Search(ctx context.Context, q storage.SearchQuery) ([]storage.SearchHit, error) {
+	terms := searchTerms(q.Query)
+
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	hits := []storage.SearchHit{}
+	for _, post := range r.db.posts {
+		if !matchPost(&post, storage.PostFilter{AuthorID: q.AuthorID}) || !matchesAll(terms, post.Title+" "+post.Body) {
+			continue
+		}
+
+		hits = append(hits, storage.SearchHit{
+			ID:           post.ID,
+			Rank:         title_weight*float32(countMatches(post.Title, terms)) + body_weight*float32(countMatches(post.Body, terms)),
+			TitleSnippet: highlight(post.Title, terms),
+			BodySnippet:  highlight(post.Body, terms),
+		})
+	}
+
+	sortHits(hits)
+	return page(hits, q.Offset, q.Limit), nil
+}
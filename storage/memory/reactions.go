@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"go_1C/storage"
+)
+
+type reaction struct {
+	userID   int64
+	reaction string
+}
+
+type reactions map[uint]map[reaction]bool
+
+func (r reactions) add(id uint, user_id int64, name string) bool {
+	key := reaction{userID: user_id, reaction: name}
+	if r[id][key] {
+		return false
+	}
+	if r[id] == nil {
+		r[id] = map[reaction]bool{}
+	}
+	r[id][key] = true
+	return true
+}
+
+func (r reactions) remove(id uint, user_id int64, name string) bool {
+	key := reaction{userID: user_id, reaction: name}
+	if !r[id][key] {
+		return false
+	}
+	delete(r[id], key)
+	return true
+}
+
+func (r reactions) of(ids []uint, user_id int64) []storage.Reactions {
+	result := make([]storage.Reactions, len(ids))
+	for i, id := range ids {
+		result[i].Counts = map[string]int64{}
+		for key := range r[id] {
+			result[i].Counts[key.reaction]++
+			if user_id != 0 && key.userID == user_id {
+				result[i].Mine = append(result[i].Mine, key.reaction)
+			}
+		}
+	}
+	return result
+}
+
+// ReactionStore keeps reactions apart from DB the same way LikeStore keeps
+// likes.
+type ReactionStore struct {
+	mu       sync.RWMutex
+	posts    reactions
+	comments reactions
+}
+
+func NewReactionStore() *ReactionStore {
+	return &ReactionStore{posts: reactions{}, comments: reactions{}}
+}
+
+func (s *ReactionStore) ReactPost(ctx context.Context, post_id uint, user_id int64, reaction string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.posts.add(post_id, user_id, reaction), nil
+}
+
+func (s *ReactionStore) UnreactPost(ctx context.Context, post_id uint, user_id int64, reaction string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.posts.remove(post_id, user_id, reaction), nil
+}
+
+func (s *ReactionStore) PostReactions(ctx context.Context, post_ids []uint, user_id int64) ([]storage.Reactions, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.posts.of(post_ids, user_id), nil
+}
+
+func (s *ReactionStore) ReactComment(ctx context.Context, comment_id uint, user_id int64, reaction string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.comments.add(comment_id, user_id, reaction), nil
+}
+
+func (s *ReactionStore) UnreactComment(ctx context.Context, comment_id uint, user_id int64, reaction string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.comments.remove(comment_id, user_id, reaction), nil
+}
+
+func (s *ReactionStore) CommentReactions(ctx context.Context, comment_ids []uint, user_id int64) ([]storage.Reactions, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.comments.of(comment_ids, user_id), nil
+}
+
+func (s *ReactionStore) RefreshPostReactions(ctx context.Context, post_id uint) error {
+	return nil
+}
+
+func (s *ReactionStore) RefreshCommentReactions(ctx context.Context, comment_id uint) error {
+	return nil
+}
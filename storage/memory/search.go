@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go_1C/storage"
+)
+
+// Weights of title and body words, the same as default weights of postgres
+// ts_rank for 'A' and 'B' labels.
+const title_weight float32 = 1.0
+const body_weight float32 = 0.4
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// searchTerms splits query into lower case words. Unlike websearch_to_tsquery
+// it has no operators: a document matches if it contains all the words.
+func searchTerms(query string) map[string]bool {
+	terms := map[string]bool{}
+	for _, word := range words(query) {
+		terms[word] = true
+	}
+	return terms
+}
+
+func words(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool { return !isWordRune(r) })
+}
+
+func countMatches(text string, terms map[string]bool) int {
+	count := 0
+	for _, word := range words(text) {
+		if terms[word] {
+			count++
+		}
+	}
+	return count
+}
+
+func matchesAll(terms map[string]bool, text string) bool {
+	if len(terms) == 0 {
+		return false
+	}
+
+	found := map[string]bool{}
+	for _, word := range words(text) {
+		if terms[word] {
+			found[word] = true
+		}
+	}
+	return len(found) == len(terms)
+}
+
+// highlight escapes the text as HTML and wraps matched words into
+// <mark></mark> like ts_headline does.
+func highlight(text string, terms map[string]bool) string {
+	var b strings.Builder
+	start := -1
+	flush := func(end int) {
+		word := html.EscapeString(text[start:end])
+		if terms[strings.ToLower(text[start:end])] {
+			b.WriteString("<mark>" + word + "</mark>")
+		} else {
+			b.WriteString(word)
+		}
+		start = -1
+	}
+
+	for i, r := range text {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			flush(i)
+		}
+		b.WriteString(html.EscapeString(string(r)))
+	}
+	if start >= 0 {
+		flush(len(text))
+	}
+	return b.String()
+}
+
+func sortHits(hits []storage.SearchHit) {
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Rank != hits[j].Rank {
+			return hits[i].Rank > hits[j].Rank
+		}
+		return hits[i].ID > hits[j].ID
+	})
+}
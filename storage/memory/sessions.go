@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go_1C/storage"
+)
+
+type session struct {
+	userID    int64
+	expiresAt time.Time
+}
+
+type SessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]session
+	// tokens of every user, so expired tokens of the user are found without
+	// scanning sessions of everybody
+	userTokens map[int64]map[string]bool
+}
+
+func NewSessionStore() *SessionStore {
+	return &SessionStore{sessions: map[string]session{}, userTokens: map[int64]map[string]bool{}}
+}
+
+// drop deletes the token from sessions and the index, s.mu must be held.
+func (s *SessionStore) drop(token string) {
+	session, ok := s.sessions[token]
+	if !ok {
+		return
+	}
+	delete(s.sessions, token)
+	delete(s.userTokens[session.userID], token)
+	if len(s.userTokens[session.userID]) == 0 {
+		delete(s.userTokens, session.userID)
+	}
+}
+
+func (s *SessionStore) SaveRefreshToken(ctx context.Context, token string, user_id int64, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// expired tokens of the user are dropped here, nobody else would take them
+	now := time.Now()
+	for token := range s.userTokens[user_id] {
+		if !now.Before(s.sessions[token].expiresAt) {
+			s.drop(token)
+		}
+	}
+
+	s.drop(token)
+	s.sessions[token] = session{userID: user_id, expiresAt: now.Add(ttl)}
+	if s.userTokens[user_id] == nil {
+		s.userTokens[user_id] = map[string]bool{}
+	}
+	s.userTokens[user_id][token] = true
+	return nil
+}
+
+func (s *SessionStore) TakeRefreshToken(ctx context.Context, token string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[token]
+	s.drop(token)
+	if !ok || !time.Now().Before(session.expiresAt) {
+		return 0, storage.ErrNotFound
+	}
+	return session.userID, nil
+}
+
+func (s *SessionStore) RefreshTokenUser(ctx context.Context, token string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[token]
+	if !ok || !time.Now().Before(session.expiresAt) {
+		return 0, storage.ErrNotFound
+	}
+	return session.userID, nil
+}
+
+func (s *SessionStore) DeleteRefreshToken(ctx context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.drop(token)
+	return nil
+}
@@ -0,0 +1,158 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+type UserRepository struct {
+	db *DB
+}
+
+func NewUserRepository(db *DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *models.User, credentials *models.Credentials) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.credentials[credentials.Login]; ok {
+		return storage.ErrAlreadyExists
+	}
+
+	user.ID = r.db.nextID("users")
+	r.db.users[user.ID] = *user
+
+	credentials.UserID = user.ID
+	stored := *credentials
+	stored.User = models.User{}
+	r.db.credentials[credentials.Login] = stored
+	return nil
+}
+
+func (r *UserRepository) Get(ctx context.Context, id uint) (*models.User, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	user, ok := r.db.users[id]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return &user, nil
+}
+
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.User, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	users := make([]models.User, 0, len(ids))
+	for _, id := range ids {
+		if user, ok := r.db.users[id]; ok {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	if _, ok := r.db.users[user.ID]; !ok {
+		return storage.ErrNotFound
+	}
+	r.db.users[user.ID] = *user
+	return nil
+}
+
+func (r *UserRepository) GetCredentials(ctx context.Context, login string) (*models.Credentials, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	credentials, ok := r.db.credentials[login]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	credentials.User = r.db.users[credentials.UserID]
+	return &credentials, nil
+}
+
+func (r *UserRepository) Follow(ctx context.Context, follower_id uint, followee_id uint) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	key := follow{followerID: follower_id, followeeID: followee_id}
+	if _, ok := r.db.follows[key]; ok {
+		return storage.ErrAlreadyExists
+	}
+	r.db.follows[key] = now()
+	return nil
+}
+
+func (r *UserRepository) Unfollow(ctx context.Context, follower_id uint, followee_id uint) error {
+	r.db.mu.Lock()
+	defer r.db.mu.Unlock()
+
+	key := follow{followerID: follower_id, followeeID: followee_id}
+	if _, ok := r.db.follows[key]; !ok {
+		return storage.ErrNotFound
+	}
+	delete(r.db.follows, key)
+	return nil
+}
+
+func (r *UserRepository) ListFollowers(ctx context.Context, user_id uint, offset int64, limit int64) ([]models.User, error) {
+	return r.listFollows(func(f follow) (uint, bool) { return f.followerID, f.followeeID == user_id }, offset, limit), nil
+}
+
+func (r *UserRepository) ListFollowing(ctx context.Context, user_id uint, offset int64, limit int64) ([]models.User, error) {
+	return r.listFollows(func(f follow) (uint, bool) { return f.followeeID, f.followerID == user_id }, offset, limit), nil
+}
+
+func (r *UserRepository) FolloweeIDs(ctx context.Context, user_id uint) ([]uint, error) {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	followee_ids := []uint{}
+	for f := range r.db.follows {
+		if f.followerID == user_id {
+			followee_ids = append(followee_ids, f.followeeID)
+		}
+	}
+	return followee_ids, nil
+}
+
+// listFollows returns other sides of matching follows, the latest first.
+func (r *UserRepository) listFollows(match func(follow) (uint, bool), offset int64, limit int64) []models.User {
+	r.db.mu.RLock()
+	defer r.db.mu.RUnlock()
+
+	type followed struct {
+		userID    uint
+		createdAt time.Time
+	}
+	var follows []followed
+	for f, created_at := range r.db.follows {
+		if user_id, ok := match(f); ok {
+			follows = append(follows, followed{userID: user_id, createdAt: created_at})
+		}
+	}
+	sort.Slice(follows, func(i, j int) bool {
+		if !follows[i].createdAt.Equal(follows[j].createdAt) {
+			return follows[i].createdAt.After(follows[j].createdAt)
+		}
+		return follows[i].userID < follows[j].userID
+	})
+
+	follows = page(follows, offset, limit)
+	users := make([]models.User, len(follows))
+	for i, f := range follows {
+		users[i] = r.db.users[f.userID]
+	}
+	return users
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"go_1C/storage"
+)
+
+type votes map[uint]map[int64]int8
+
+func (v votes) set(id uint, user_id int64, vote int8) storage.Votes {
+	if vote == 0 {
+		delete(v[id], user_id)
+	} else {
+		if v[id] == nil {
+			v[id] = map[int64]int8{}
+		}
+		v[id][user_id] = vote
+	}
+	return v.of(id, user_id)
+}
+
+func (v votes) of(id uint, user_id int64) storage.Votes {
+	var result storage.Votes
+	for voter, vote := range v[id] {
+		if vote > 0 {
+			result.Up++
+		} else {
+			result.Down++
+		}
+		if user_id != 0 && voter == user_id {
+			result.Mine = vote
+		}
+	}
+	return result
+}
+
+// VoteStore keeps votes apart from DB the same way LikeStore keeps likes,
+// votes counters of posts are copied to DB too. Existence of posts and
+// comments is checked by the service.
+type VoteStore struct {
+	db       *DB
+	mu       sync.RWMutex
+	posts    votes
+	comments votes
+}
+
+func NewVoteStore(db *DB) *VoteStore {
+	return &VoteStore{db: db, posts: votes{}, comments: votes{}}
+}
+
+func (s *VoteStore) VotePost(ctx context.Context, post_id uint, user_id int64, vote int8) (storage.Votes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	votes := s.posts.set(post_id, user_id, vote)
+
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	if post, ok := s.db.posts[post_id]; ok {
+		post.Upvotes, post.Downvotes = votes.Up, votes.Down
+		s.db.posts[post_id] = post
+	}
+	return votes, nil
+}
+
+func (s *VoteStore) PostVotes(ctx context.Context, post_ids []uint, user_id int64) ([]storage.Votes, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]storage.Votes, len(post_ids))
+	for i, post_id := range post_ids {
+		result[i] = s.posts.of(post_id, user_id)
+	}
+	return result, nil
+}
+
+func (s *VoteStore) VoteComment(ctx context.Context, comment_id uint, user_id int64, vote int8) (storage.Votes, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.comments.set(comment_id, user_id, vote), nil
+}
+
+func (s *VoteStore) CommentVotes(ctx context.Context, comment_ids []uint, user_id int64) ([]storage.Votes, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]storage.Votes, len(comment_ids))
+	for i, comment_id := range comment_ids {
+		result[i] = s.comments.of(comment_id, user_id)
+	}
+	return result, nil
+}
@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/pagination"
+	"go_1C/storage"
+)
+
+// Deleted comment stays in the thread while it has replies, so the replies
+// are not orphaned.
+const visible_comment_cond = "comments.deleted_at IS NULL OR EXISTS (SELECT 1 FROM comments AS replies WHERE replies.parent_id = comments.id)"
+
+// Comments of soft deleted post are kept for its restore, but not listed.
+const live_post_cond = "EXISTS (SELECT 1 FROM posts WHERE posts.id = comments.post_refer AND posts.deleted_at IS NULL)"
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func (r *CommentRepository) comments(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Preload("Author")
+}
+
+func (r *CommentRepository) Create(ctx context.Context, comment *models.Comment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return err
+		}
+		if err := changeCommentsCount(tx, comment.ID, 1); err != nil {
+			return err
+		}
+		if err := updateCommentSearchVector(tx, comment.ID); err != nil {
+			return err
+		}
+		return tx.Preload("Author").First(comment, comment.ID).Error
+	})
+}
+
+func (r *CommentRepository) Get(ctx context.Context, id uint) (*models.Comment, error) {
+	var comment models.Comment
+	if err := r.comments(ctx).Where("id = ?", id).First(&comment).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &comment, nil
+}
+
+func (r *CommentRepository) GetUnscoped(ctx context.Context, id uint) (*models.Comment, error) {
+	var comment models.Comment
+	if err := r.comments(ctx).Unscoped().Where("id = ?", id).First(&comment).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &comment, nil
+}
+
+func (r *CommentRepository) Update(ctx context.Context, comment *models.Comment, revision *models.CommentRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+		if err := tx.Save(comment).Error; err != nil {
+			return err
+		}
+		return updateCommentSearchVector(tx, comment.ID)
+	})
+}
+
+func (r *CommentRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.Comment{}, id)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return changeCommentsCount(tx, id, -1)
+	})
+}
+
+func (r *CommentRepository) Restore(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&models.Comment{}).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return changeCommentsCount(tx, id, 1)
+	})
+}
+
+// changeCommentsCount adds delta to comments counter of the post of the
+// comment. Deleted posts keep counting, so the counter is right on restore.
+func changeCommentsCount(tx *gorm.DB, comment_id uint, delta int64) error {
+	return tx.Exec(`UPDATE posts SET comments_count = comments_count + ?
+		WHERE id = (SELECT post_refer FROM comments WHERE id = ?)`, delta, comment_id).Error
+}
+
+// RepairCommentsCount sets comments counters of posts to the number of not
+// deleted comments where they differ and returns number of repaired posts.
+func RepairCommentsCount(ctx context.Context, db *gorm.DB) (int64, error) {
+	result := db.WithContext(ctx).Exec(`UPDATE posts SET comments_count = counted.comments
+		FROM (
+			SELECT posts.id, COUNT(comments.id) AS comments
+			FROM posts LEFT JOIN comments ON comments.post_refer = posts.id AND comments.deleted_at IS NULL
+			GROUP BY posts.id
+		) AS counted
+		WHERE posts.id = counted.id AND posts.comments_count <> counted.comments`)
+	return result.RowsAffected, result.Error
+}
+
+func (r *CommentRepository) ListByPost(ctx context.Context, post_id uint, cursor *pagination.Cursor, offset int64, limit int64) ([]models.Comment, error) {
+	query := r.comments(ctx).Where("post_refer = ?", post_id).Where(live_post_cond).Order("created_at, id")
+	if cursor != nil {
+		query = query.Where("(created_at, id) > (?, ?)", cursor.CreatedAt, cursor.ID)
+	} else {
+		query = query.Offset(int(offset))
+	}
+
+	var comments []models.Comment
+	if err := query.Limit(int(limit)).Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) ListByAuthor(ctx context.Context, author_id uint, offset int64, limit int64) ([]models.Comment, error) {
+	var comments []models.Comment
+	if err := r.comments(ctx).Where("author_id = ?", author_id).Where(live_post_cond).Order("id DESC").Offset(int(offset)).Limit(int(limit)).Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) ListThread(ctx context.Context, post_id uint, parent_id *uint, offset int64, limit int64) ([]models.Comment, error) {
+	query := r.comments(ctx).Unscoped().Where(visible_comment_cond).Where(live_post_cond)
+	if parent_id != nil {
+		query = query.Where("parent_id = ?", *parent_id)
+	} else {
+		query = query.Where("post_refer = ? AND parent_id IS NULL", post_id)
+	}
+
+	var comments []models.Comment
+	if err := query.Order("created_at, id").Offset(int(offset)).Limit(int(limit)).Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *CommentRepository) ListReplies(ctx context.Context, parent_ids []uint, limit int64) ([]models.Comment, error) {
+	if len(parent_ids) == 0 {
+		return []models.Comment{}, nil
+	}
+
+	ranked := r.db.WithContext(ctx).Unscoped().Model(&models.Comment{}).
+		Select("comments.*, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY created_at, id) AS reply_rank").
+		Where("parent_id IN ?", parent_ids).Where(visible_comment_cond)
+
+	var replies []models.Comment
+	if err := r.comments(ctx).Unscoped().Table("(?) AS comments", ranked).Where("reply_rank <= ?", limit).
+		Order("created_at, id").Find(&replies).Error; err != nil {
+		return nil, err
+	}
+	return replies, nil
+}
+
+func (r *CommentRepository) ReplyCounts(ctx context.Context, ids []uint) (map[uint]int64, error) {
+	by_parent := make(map[uint]int64, len(ids))
+	if len(ids) == 0 {
+		return by_parent, nil
+	}
+
+	var counts []struct {
+		ParentID uint
+		Count    int64
+	}
+	if err := r.db.WithContext(ctx).Model(&models.Comment{}).Select("parent_id, COUNT(*) AS count").Where("parent_id IN ?", ids).Group("parent_id").Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	for _, count := range counts {
+		by_parent[count.ParentID] = count.Count
+	}
+	return by_parent, nil
+}
+
+func (r *CommentRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Comment, error) {
+	if len(ids) == 0 {
+		return []models.Comment{}, nil
+	}
+
+	var comments []models.Comment
+	if err := r.comments(ctx).Where("id IN ?", ids).Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	orderByIDs(comments, ids, func(comment *models.Comment) uint { return comment.ID })
+	return comments, nil
+}
+
+func (r *CommentRepository) ExistingIDs(ctx context.Context, ids []uint) ([]uint, error) {
+	existing := []uint{}
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Comment{}).Where("id IN ?", ids).Pluck("id", &existing).Error
+	return existing, err
+}
+
+func (r *CommentRepository) Revisions(ctx context.Context, comment_id uint, offset int64, limit int64) ([]models.CommentRevision, error) {
+	var revisions []models.CommentRevision
+	if err := r.db.WithContext(ctx).Where("comment_id = ?", comment_id).Order("id DESC").Offset(int(offset)).Limit(int(limit)).Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (r *CommentRepository) Search(ctx context.Context, q storage.SearchQuery) ([]storage.SearchHit, error) {
+	var hits []storage.SearchHit
+	err := r.db.WithContext(ctx).Raw(`SELECT comments.id, ts_rank(comments.search_vector, query) AS rank,
+			ts_headline(`+search_config+`, `+escapedHTML("comments.body")+`, query, @options) AS body_snippet
+		FROM comments, websearch_to_tsquery(`+search_config+`, @query) AS query
+		WHERE comments.search_vector @@ query AND comments.deleted_at IS NULL AND `+live_post_cond+`
+			AND (@author_id = 0 OR comments.author_id = @author_id)
+			AND (@post_id = 0 OR comments.post_refer = @post_id)
+		ORDER BY rank DESC, comments.id DESC
+		OFFSET @offset LIMIT @limit`,
+		map[string]interface{}{
+			"options":   headline_options,
+			"query":     q.Query,
+			"author_id": q.AuthorID,
+			"post_id":   q.PostID,
+			"offset":    q.Offset,
+			"limit":     q.Limit,
+		}).Scan(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
@@ -0,0 +1,112 @@
+-- Schema as it was created by gorm AutoMigrate. Tables and indexes are
+-- created only if missing, so databases migrated by gorm are adopted as is.
+-- Databases migrated by older versions miss columns added later, those
+-- columns are added below.
+
+CREATE TABLE IF NOT EXISTS users (
+    id bigserial PRIMARY KEY,
+    name text,
+    bio text NOT NULL DEFAULT '',
+    avatar_url varchar(255) NOT NULL DEFAULT ''
+);
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS bio text NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS avatar_url varchar(255) NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS credentials (
+    user_id bigint PRIMARY KEY,
+    login varchar(50) NOT NULL,
+    password_hash bytea NOT NULL,
+    salt bytea NOT NULL,
+    CONSTRAINT fk_credentials_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_credentials_login ON credentials (login);
+
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id bigint,
+    followee_id bigint,
+    created_at timestamptz,
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT fk_follows_follower FOREIGN KEY (follower_id) REFERENCES users (id),
+    CONSTRAINT fk_follows_followee FOREIGN KEY (followee_id) REFERENCES users (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_follows_followee_id ON follows (followee_id);
+
+CREATE TABLE IF NOT EXISTS posts (
+    id bigserial PRIMARY KEY,
+    title text,
+    body text NOT NULL,
+    author_id bigint NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    edited_at timestamptz,
+    deleted_at timestamptz,
+    search_vector tsvector,
+    CONSTRAINT fk_posts_author FOREIGN KEY (author_id) REFERENCES users (id)
+);
+
+ALTER TABLE posts
+    ADD COLUMN IF NOT EXISTS created_at timestamptz,
+    ADD COLUMN IF NOT EXISTS updated_at timestamptz,
+    ADD COLUMN IF NOT EXISTS edited_at timestamptz,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz,
+    ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+-- Posts created before timestamps were added are paged as created now.
+UPDATE posts SET created_at = now() WHERE created_at IS NULL;
+UPDATE posts SET updated_at = created_at WHERE updated_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_posts_deleted_at ON posts (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_posts_search_vector ON posts USING gin (search_vector);
+
+CREATE TABLE IF NOT EXISTS comments (
+    id bigserial PRIMARY KEY,
+    post_refer bigint NOT NULL,
+    parent_id bigint,
+    author_id bigint NOT NULL,
+    body text NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    edited_at timestamptz,
+    deleted_at timestamptz,
+    search_vector tsvector,
+    CONSTRAINT fk_posts_comments FOREIGN KEY (post_refer) REFERENCES posts (id),
+    CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users (id)
+);
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS parent_id bigint,
+    ADD COLUMN IF NOT EXISTS created_at timestamptz,
+    ADD COLUMN IF NOT EXISTS updated_at timestamptz,
+    ADD COLUMN IF NOT EXISTS edited_at timestamptz,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz,
+    ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+UPDATE comments SET created_at = now() WHERE created_at IS NULL;
+UPDATE comments SET updated_at = created_at WHERE updated_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_comments_search_vector ON comments USING gin (search_vector);
+
+CREATE TABLE IF NOT EXISTS post_revisions (
+    id bigserial PRIMARY KEY,
+    post_id bigint NOT NULL,
+    title varchar(100) NOT NULL,
+    body text NOT NULL,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_revisions_post_id ON post_revisions (post_id);
+
+CREATE TABLE IF NOT EXISTS comment_revisions (
+    id bigserial PRIMARY KEY,
+    comment_id bigint NOT NULL,
+    body text NOT NULL,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_comment_revisions_comment_id ON comment_revisions (comment_id);
@@ -0,0 +1,34 @@
+DROP INDEX IF EXISTS idx_comments_author_id;
+DROP INDEX IF EXISTS idx_comments_post_refer;
+DROP INDEX IF EXISTS idx_posts_author_id;
+DROP INDEX IF EXISTS idx_posts_created_at;
+
+ALTER TABLE comment_revisions DROP CONSTRAINT IF EXISTS fk_comment_revisions_comment;
+ALTER TABLE post_revisions DROP CONSTRAINT IF EXISTS fk_post_revisions_post;
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS fk_comments_author,
+    DROP CONSTRAINT IF EXISTS fk_comments_parent,
+    DROP CONSTRAINT IF EXISTS fk_comments_post,
+    ADD CONSTRAINT fk_posts_comments FOREIGN KEY (post_refer) REFERENCES posts (id),
+    ADD CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users (id);
+
+ALTER TABLE posts
+    DROP CONSTRAINT IF EXISTS fk_posts_author,
+    ADD CONSTRAINT fk_posts_author FOREIGN KEY (author_id) REFERENCES users (id);
+
+ALTER TABLE follows
+    DROP CONSTRAINT IF EXISTS fk_follows_followee,
+    DROP CONSTRAINT IF EXISTS fk_follows_follower,
+    ADD CONSTRAINT fk_follows_follower FOREIGN KEY (follower_id) REFERENCES users (id),
+    ADD CONSTRAINT fk_follows_followee FOREIGN KEY (followee_id) REFERENCES users (id);
+
+ALTER TABLE credentials
+    DROP CONSTRAINT IF EXISTS fk_credentials_user,
+    ADD CONSTRAINT fk_credentials_user FOREIGN KEY (user_id) REFERENCES users (id);
+
+ALTER TABLE posts ALTER COLUMN title DROP NOT NULL;
+ALTER TABLE posts ALTER COLUMN title TYPE text;
+
+ALTER TABLE users ALTER COLUMN name DROP NOT NULL;
+ALTER TABLE users ALTER COLUMN name TYPE text;
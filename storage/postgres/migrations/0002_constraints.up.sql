@@ -0,0 +1,51 @@
+-- Length limits of models.go were never applied because of misspelled tags.
+-- Longer values are cut to the limits checked by the API.
+UPDATE users SET name = '' WHERE name IS NULL;
+ALTER TABLE users ALTER COLUMN name TYPE varchar(50) USING left(name, 50);
+ALTER TABLE users ALTER COLUMN name SET NOT NULL;
+
+UPDATE posts SET title = '' WHERE title IS NULL;
+ALTER TABLE posts ALTER COLUMN title TYPE varchar(100) USING left(title, 100);
+ALTER TABLE posts ALTER COLUMN title SET NOT NULL;
+
+-- Rows are soft deleted by the service, so cascades only fire when rows are
+-- removed by hand: everything owned by the removed row goes with it.
+ALTER TABLE credentials
+    DROP CONSTRAINT IF EXISTS fk_credentials_user,
+    ADD CONSTRAINT fk_credentials_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+
+ALTER TABLE follows
+    DROP CONSTRAINT IF EXISTS fk_follows_follower,
+    DROP CONSTRAINT IF EXISTS fk_follows_followee,
+    ADD CONSTRAINT fk_follows_follower FOREIGN KEY (follower_id) REFERENCES users (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_follows_followee FOREIGN KEY (followee_id) REFERENCES users (id) ON DELETE CASCADE;
+
+ALTER TABLE posts
+    DROP CONSTRAINT IF EXISTS fk_posts_author,
+    ADD CONSTRAINT fk_posts_author FOREIGN KEY (author_id) REFERENCES users (id) ON DELETE CASCADE;
+
+-- Replies and revisions had no foreign keys: replies left without parent
+-- become top level comments, revisions left without post or comment are dropped.
+UPDATE comments SET parent_id = NULL WHERE parent_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM comments AS parents WHERE parents.id = comments.parent_id);
+DELETE FROM post_revisions WHERE NOT EXISTS (SELECT 1 FROM posts WHERE posts.id = post_revisions.post_id);
+DELETE FROM comment_revisions WHERE NOT EXISTS (SELECT 1 FROM comments WHERE comments.id = comment_revisions.comment_id);
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS fk_posts_comments,
+    DROP CONSTRAINT IF EXISTS fk_comments_author,
+    ADD CONSTRAINT fk_comments_post FOREIGN KEY (post_refer) REFERENCES posts (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users (id) ON DELETE CASCADE;
+
+ALTER TABLE post_revisions
+    ADD CONSTRAINT fk_post_revisions_post FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE;
+
+ALTER TABLE comment_revisions
+    ADD CONSTRAINT fk_comment_revisions_comment FOREIGN KEY (comment_id) REFERENCES comments (id) ON DELETE CASCADE;
+
+-- GetPosts pages by (created_at, id), ListUserPosts and ListUserComments
+-- filter by author, GetComments filters by post and pages by (created_at, id).
+CREATE INDEX idx_posts_created_at ON posts (created_at, id);
+CREATE INDEX idx_posts_author_id ON posts (author_id, created_at);
+CREATE INDEX idx_comments_post_refer ON comments (post_refer, created_at, id);
+CREATE INDEX idx_comments_author_id ON comments (author_id);
@@ -0,0 +1,10 @@
+-- Side effects on redis saved together with the change of postgres, see
+-- models.OutboxEvent. Events are applied in order of id.
+CREATE TABLE outbox_events (
+    id bigserial PRIMARY KEY,
+    kind text NOT NULL,
+    entity_id bigint NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT '',
+    created_at timestamptz
+);
@@ -0,0 +1,5 @@
+ALTER TABLE comments DROP COLUMN IF EXISTS likes_count;
+ALTER TABLE posts DROP COLUMN IF EXISTS likes_count;
+
+DROP TABLE IF EXISTS comment_likes;
+DROP TABLE IF EXISTS post_likes;
@@ -0,0 +1,28 @@
+-- Likes were kept only in redis sets, now postgres is the source of truth
+-- and redis caches them. Likes already stored in redis are imported by the
+-- likes store on the first start, see LikeStore.Warm.
+CREATE TABLE post_likes (
+    post_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (post_id, user_id),
+    CONSTRAINT fk_post_likes_post FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE,
+    CONSTRAINT fk_post_likes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_likes_user_id ON post_likes (user_id);
+
+CREATE TABLE comment_likes (
+    comment_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (comment_id, user_id),
+    CONSTRAINT fk_comment_likes_comment FOREIGN KEY (comment_id) REFERENCES comments (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comment_likes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_comment_likes_user_id ON comment_likes (user_id);
+
+-- Counters are changed in the same transaction as the likes.
+ALTER TABLE posts ADD COLUMN likes_count bigint NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN likes_count bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,25 @@
+-- Emoji reactions besides likes, the user may leave several different
+-- reactions. Names of reactions are checked by the service.
+CREATE TABLE post_reactions (
+    post_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    reaction varchar(32) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (post_id, user_id, reaction),
+    CONSTRAINT fk_post_reactions_post FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE,
+    CONSTRAINT fk_post_reactions_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_reactions_user_id ON post_reactions (user_id);
+
+CREATE TABLE comment_reactions (
+    comment_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    reaction varchar(32) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (comment_id, user_id, reaction),
+    CONSTRAINT fk_comment_reactions_comment FOREIGN KEY (comment_id) REFERENCES comments (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comment_reactions_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_comment_reactions_user_id ON comment_reactions (user_id);
@@ -0,0 +1,32 @@
+-- Up and down votes, the user has at most one vote for every post and
+-- comment. Counters are changed in the same transaction as the votes.
+CREATE TABLE post_votes (
+    post_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    value smallint NOT NULL CHECK (value IN (-1, 1)),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (post_id, user_id),
+    CONSTRAINT fk_post_votes_post FOREIGN KEY (post_id) REFERENCES posts (id) ON DELETE CASCADE,
+    CONSTRAINT fk_post_votes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_post_votes_user_id ON post_votes (user_id);
+
+CREATE TABLE comment_votes (
+    comment_id bigint NOT NULL,
+    user_id bigint NOT NULL,
+    value smallint NOT NULL CHECK (value IN (-1, 1)),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (comment_id, user_id),
+    CONSTRAINT fk_comment_votes_comment FOREIGN KEY (comment_id) REFERENCES comments (id) ON DELETE CASCADE,
+    CONSTRAINT fk_comment_votes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_comment_votes_user_id ON comment_votes (user_id);
+
+ALTER TABLE posts ADD COLUMN upvotes bigint NOT NULL DEFAULT 0;
+ALTER TABLE posts ADD COLUMN downvotes bigint NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN upvotes bigint NOT NULL DEFAULT 0;
+ALTER TABLE comments ADD COLUMN downvotes bigint NOT NULL DEFAULT 0;
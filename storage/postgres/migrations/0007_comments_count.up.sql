@@ -0,0 +1,13 @@
+-- Number of not deleted comments of the post, so listing posts does not load
+-- every comment. The counter is changed in the same transaction as the
+-- comments and can be recomputed by the repair-comments-count command.
+ALTER TABLE posts ADD COLUMN comments_count bigint NOT NULL DEFAULT 0;
+
+UPDATE posts SET comments_count = counted.comments
+FROM (
+    SELECT post_refer, COUNT(*) AS comments
+    FROM comments
+    WHERE deleted_at IS NULL
+    GROUP BY post_refer
+) AS counted
+WHERE posts.id = counted.post_refer;
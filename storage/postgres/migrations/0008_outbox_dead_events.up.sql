@@ -0,0 +1,5 @@
+-- Events failed too many times are kept with dead_at set and skipped, so
+-- they do not block later events, see storage.MaxEventAttempts.
+ALTER TABLE outbox_events ADD COLUMN dead_at timestamptz;
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events (id) WHERE dead_at IS NULL;
@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+// addEvent saves outbox event in the transaction of the change.
+func addEvent(tx *gorm.DB, kind string, entity_id uint) error {
+	return tx.Create(&models.OutboxEvent{Kind: kind, EntityID: entity_id}).Error
+}
+
+type Outbox struct {
+	db *gorm.DB
+}
+
+func NewOutbox(db *gorm.DB) *Outbox {
+	return &Outbox{db: db}
+}
+
+// Key of postgres advisory lock held while the batch is processed.
+const outbox_lock_key int64 = 0x6f7574626f78 // "outbox"
+
+// Process handles the batch on one replica at a time, other replicas skip
+// the round, so events are applied in order.
+func (o *Outbox) Process(ctx context.Context, limit int, apply func(event models.OutboxEvent) error) (int, error) {
+	applied := 0
+	var apply_err error
+	err := o.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", outbox_lock_key).Scan(&locked).Error; err != nil || !locked {
+			return err
+		}
+
+		var events []models.OutboxEvent
+		if err := tx.Where("dead_at IS NULL").Order("id").Limit(limit).Find(&events).Error; err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if apply_err = apply(event); apply_err != nil {
+				updates := map[string]any{
+					"attempts":   gorm.Expr("attempts + 1"),
+					"last_error": apply_err.Error(),
+				}
+				event.Attempts++
+				if event.Attempts >= storage.MaxEventAttempts {
+					updates["dead_at"] = gorm.Expr("now()")
+					apply_err = &storage.DeadEventError{Event: event, Err: apply_err}
+				}
+				// the failure is committed with deletion of applied events
+				return tx.Model(&event).Updates(updates).Error
+			}
+			if err := tx.Delete(&event).Error; err != nil {
+				return err
+			}
+			applied++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return applied, apply_err
+}
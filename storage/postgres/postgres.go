@@ -0,0 +1,39 @@
+// Package postgres implements repositories of posts, comments and users on
+// top of gorm.
+package postgres
+
+import (
+	"errors"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"go_1C/storage"
+)
+
+// notFound converts gorm error to storage.ErrNotFound.
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return storage.ErrNotFound
+	}
+	return err
+}
+
+// orderByIDs restores order of rows loaded by ids.
+func orderByIDs[T any](rows []T, ids []uint, id func(*T) uint) {
+	position := make(map[uint]int, len(ids))
+	for i, id := range ids {
+		position[id] = i
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return position[id(&rows[i])] < position[id(&rows[j])]
+	})
+}
+
+var (
+	_ storage.PostRepository    = (*PostRepository)(nil)
+	_ storage.CommentRepository = (*CommentRepository)(nil)
+	_ storage.UserRepository    = (*UserRepository)(nil)
+	_ storage.Outbox            = (*Outbox)(nil)
+	_ storage.VoteStore         = (*VoteStore)(nil)
+)
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+// Score of storage.PostsHot order, age is counted in hours.
+var hot_score = fmt.Sprintf("likes_count / power(GREATEST(EXTRACT(EPOCH FROM now() - created_at) / 3600, 0) + 2, %g)", storage.HotGravity)
+
+type PostRepository struct {
+	db *gorm.DB
+}
+
+func NewPostRepository(db *gorm.DB) *PostRepository {
+	return &PostRepository{db: db}
+}
+
+func (r *PostRepository) posts(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Preload("Author")
+}
+
+// filterPosts applies author and time window filters.
+func filterPosts(query *gorm.DB, filter storage.PostFilter) *gorm.DB {
+	if filter.AuthorID != 0 {
+		query = query.Where("posts.author_id = ?", filter.AuthorID)
+	}
+	if filter.CreatedAfter != nil {
+		query = query.Where("posts.created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("posts.created_at < ?", *filter.CreatedBefore)
+	}
+	return query
+}
+
+func (r *PostRepository) Create(ctx context.Context, post *models.Post) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(post).Error; err != nil {
+			return err
+		}
+		if err := updatePostSearchVector(tx, post.ID); err != nil {
+			return err
+		}
+		if err := addEvent(tx, storage.EventPostCreated, post.ID); err != nil {
+			return err
+		}
+		return tx.Preload("Author").First(post, post.ID).Error
+	})
+}
+
+func (r *PostRepository) Get(ctx context.Context, id uint) (*models.Post, error) {
+	var post models.Post
+	if err := r.posts(ctx).Where("id = ?", id).First(&post).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &post, nil
+}
+
+func (r *PostRepository) GetUnscoped(ctx context.Context, id uint) (*models.Post, error) {
+	var post models.Post
+	if err := r.posts(ctx).Unscoped().Where("id = ?", id).First(&post).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &post, nil
+}
+
+func (r *PostRepository) Update(ctx context.Context, post *models.Post, revision *models.PostRevision) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+		if err := tx.Save(post).Error; err != nil {
+			return err
+		}
+		return updatePostSearchVector(tx, post.ID)
+	})
+}
+
+func (r *PostRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.Post{}, id)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return addEvent(tx, storage.EventPostDeleted, id)
+	})
+}
+
+func (r *PostRepository) Restore(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(&models.Post{}).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return addEvent(tx, storage.EventPostRestored, id)
+	})
+}
+
+func (r *PostRepository) Find(ctx context.Context, q storage.PostQuery) ([]models.Post, error) {
+	query := filterPosts(r.posts(ctx), q.PostFilter)
+	switch q.Order {
+	case storage.PostsNewest:
+		query = query.Order("created_at DESC, id DESC")
+		if q.Cursor != nil {
+			query = query.Where("(created_at, id) < (?, ?)", q.Cursor.CreatedAt, q.Cursor.ID)
+		}
+	case storage.PostsMostCommented:
+		query = query.Order("comments_count DESC, id DESC")
+	case storage.PostsTopLiked:
+		query = query.Order("likes_count DESC, id DESC")
+	case storage.PostsHot:
+		query = query.Order(hot_score + " DESC, id DESC")
+	case storage.PostsTopScore:
+		query = query.Order("upvotes - downvotes DESC, created_at DESC, id DESC")
+	default:
+		query = query.Order("created_at, id")
+		if q.Cursor != nil {
+			query = query.Where("(created_at, id) > (?, ?)", q.Cursor.CreatedAt, q.Cursor.ID)
+		}
+	}
+	if q.Cursor == nil {
+		query = query.Offset(int(q.Offset))
+	}
+
+	var posts []models.Post
+	if err := query.Limit(int(q.Limit)).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+func (r *PostRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.Post, error) {
+	if len(ids) == 0 {
+		return []models.Post{}, nil
+	}
+
+	var posts []models.Post
+	if err := r.posts(ctx).Where("id IN ?", ids).Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	orderByIDs(posts, ids, func(post *models.Post) uint { return post.ID })
+	return posts, nil
+}
+
+func (r *PostRepository) ExistingIDs(ctx context.Context, ids []uint) ([]uint, error) {
+	existing := []uint{}
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	err := r.db.WithContext(ctx).Unscoped().Model(&models.Post{}).Where("id IN ?", ids).Pluck("id", &existing).Error
+	return existing, err
+}
+
+func (r *PostRepository) Revisions(ctx context.Context, post_id uint, offset int64, limit int64) ([]models.PostRevision, error) {
+	var revisions []models.PostRevision
+	if err := r.db.WithContext(ctx).Where("post_id = ?", post_id).Order("id DESC").Offset(int(offset)).Limit(int(limit)).Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (r *PostRepository) Search(ctx context.Context, q storage.SearchQuery) ([]storage.SearchHit, error) {
+	var hits []storage.SearchHit
+	err := r.db.WithContext(ctx).Raw(`SELECT posts.id, ts_rank(posts.search_vector, query) AS rank,
+			ts_headline(`+search_config+`, `+escapedHTML("posts.title")+`, query, @options) AS title_snippet,
+			ts_headline(`+search_config+`, `+escapedHTML("posts.body")+`, query, @options) AS body_snippet
+		FROM posts, websearch_to_tsquery(`+search_config+`, @query) AS query
+		WHERE posts.search_vector @@ query AND posts.deleted_at IS NULL
+			AND (@author_id = 0 OR posts.author_id = @author_id)
+		ORDER BY rank DESC, posts.id DESC
+		OFFSET @offset LIMIT @limit`,
+		map[string]interface{}{
+			"options":   headline_options,
+			"query":     q.Query,
+			"author_id": q.AuthorID,
+			"offset":    q.Offset,
+			"limit":     q.Limit,
+		}).Scan(&hits).Error
+	if err != nil {
+		return nil, err
+	}
+	return hits, nil
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"gorm.io/gorm"
+)
+
+// Posts are written in different languages, so text is not stemmed.
+const search_config = "'simple'"
+
+const headline_options = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10"
+
+// escapedHTML returns SQL expression escaping the text column as
+// html.EscapeString does. Snippets are made of escaped text, so the only
+// markup in them is <mark></mark> added by ts_headline.
+func escapedHTML(column string) string {
+	return "replace(replace(replace(replace(replace(" + column + ", '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '''', '&#39;'), '\"', '&#34;')"
+}
+
+const post_search_vector = "setweight(to_tsvector(" + search_config + ", title), 'A') || setweight(to_tsvector(" + search_config + ", body), 'B')"
+
+const comment_search_vector = "to_tsvector(" + search_config + ", body)"
+
+func updatePostSearchVector(tx *gorm.DB, post_id uint) error {
+	return tx.Exec("UPDATE posts SET search_vector = "+post_search_vector+" WHERE id = ?", post_id).Error
+}
+
+func updateCommentSearchVector(tx *gorm.DB, comment_id uint) error {
+	return tx.Exec("UPDATE comments SET search_vector = "+comment_search_vector+" WHERE id = ?", comment_id).Error
+}
+
+// BackfillSearchVectors fills vectors of rows created before search was added.
+func BackfillSearchVectors(db *gorm.DB) error {
+	if err := db.Exec("UPDATE posts SET search_vector = " + post_search_vector + " WHERE search_vector IS NULL").Error; err != nil {
+		return err
+	}
+	return db.Exec("UPDATE comments SET search_vector = " + comment_search_vector + " WHERE search_vector IS NULL").Error
+}
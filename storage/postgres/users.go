@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+type UserRepository struct {
+	db *gorm.DB
+}
+
+func NewUserRepository(db *gorm.DB) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *models.User, credentials *models.Credentials) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Credentials{}).Where("login = ?", credentials.Login).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return storage.ErrAlreadyExists
+		}
+
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+
+		credentials.UserID = user.ID
+		return tx.Create(credentials).Error
+	})
+}
+
+func (r *UserRepository) Get(ctx context.Context, id uint) (*models.User, error) {
+	var user models.User
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&user).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &user, nil
+}
+
+func (r *UserRepository) FindByIDs(ctx context.Context, ids []uint) ([]models.User, error) {
+	if len(ids) == 0 {
+		return []models.User{}, nil
+	}
+
+	var users []models.User
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	orderByIDs(users, ids, func(user *models.User) uint { return user.ID })
+	return users, nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
+	return r.db.WithContext(ctx).Model(user).Select("name", "bio", "avatar_url").Updates(user).Error
+}
+
+func (r *UserRepository) GetCredentials(ctx context.Context, login string) (*models.Credentials, error) {
+	var credentials models.Credentials
+	if err := r.db.WithContext(ctx).Where("login = ?", login).Preload("User").First(&credentials).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &credentials, nil
+}
+
+func (r *UserRepository) Follow(ctx context.Context, follower_id uint, followee_id uint) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.Follow{}).Where("follower_id = ? AND followee_id = ?", follower_id, followee_id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return storage.ErrAlreadyExists
+	}
+
+	return r.db.WithContext(ctx).Create(&models.Follow{FollowerID: follower_id, FolloweeID: followee_id}).Error
+}
+
+func (r *UserRepository) Unfollow(ctx context.Context, follower_id uint, followee_id uint) error {
+	result := r.db.WithContext(ctx).Where("follower_id = ? AND followee_id = ?", follower_id, followee_id).Delete(&models.Follow{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) ListFollowers(ctx context.Context, user_id uint, offset int64, limit int64) ([]models.User, error) {
+	var follows []models.Follow
+	if err := r.db.WithContext(ctx).Where("followee_id = ?", user_id).Preload("Follower").Order("created_at DESC, follower_id").Offset(int(offset)).Limit(int(limit)).Find(&follows).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]models.User, len(follows))
+	for i, follow := range follows {
+		users[i] = follow.Follower
+	}
+	return users, nil
+}
+
+func (r *UserRepository) ListFollowing(ctx context.Context, user_id uint, offset int64, limit int64) ([]models.User, error) {
+	var follows []models.Follow
+	if err := r.db.WithContext(ctx).Where("follower_id = ?", user_id).Preload("Followee").Order("created_at DESC, followee_id").Offset(int(offset)).Limit(int(limit)).Find(&follows).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]models.User, len(follows))
+	for i, follow := range follows {
+		users[i] = follow.Followee
+	}
+	return users, nil
+}
+
+func (r *UserRepository) FolloweeIDs(ctx context.Context, user_id uint) ([]uint, error) {
+	followee_ids := []uint{}
+	err := r.db.WithContext(ctx).Model(&models.Follow{}).Where("follower_id = ?", user_id).Pluck("followee_id", &followee_ids).Error
+	return followee_ids, err
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"go_1C/storage"
+)
+
+// votesTable describes where votes of posts or comments are kept.
+type votesTable struct {
+	// table of votes and its column referencing the voted row
+	table  string
+	column string
+	// table of voted rows with upvotes and downvotes columns
+	counted string
+}
+
+var (
+	post_votes    = votesTable{table: "post_votes", column: "post_id", counted: "posts"}
+	comment_votes = votesTable{table: "comment_votes", column: "comment_id", counted: "comments"}
+)
+
+type votesCounters struct {
+	Upvotes   int64
+	Downvotes int64
+}
+
+// VoteStore keeps votes together with their counters in voted rows.
+type VoteStore struct {
+	db *gorm.DB
+}
+
+func NewVoteStore(db *gorm.DB) *VoteStore {
+	return &VoteStore{db: db}
+}
+
+func (s *VoteStore) VotePost(ctx context.Context, post_id uint, user_id int64, vote int8) (storage.Votes, error) {
+	return s.vote(ctx, post_votes, post_id, user_id, vote)
+}
+
+func (s *VoteStore) PostVotes(ctx context.Context, post_ids []uint, user_id int64) ([]storage.Votes, error) {
+	return s.votes(ctx, post_votes, post_ids, user_id)
+}
+
+func (s *VoteStore) VoteComment(ctx context.Context, comment_id uint, user_id int64, vote int8) (storage.Votes, error) {
+	return s.vote(ctx, comment_votes, comment_id, user_id, vote)
+}
+
+func (s *VoteStore) CommentVotes(ctx context.Context, comment_ids []uint, user_id int64) ([]storage.Votes, error) {
+	return s.votes(ctx, comment_votes, comment_ids, user_id)
+}
+
+func (s *VoteStore) vote(ctx context.Context, t votesTable, id uint, user_id int64, vote int8) (storage.Votes, error) {
+	var votes storage.Votes
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// votes of the row are changed one at a time, so concurrent votes of
+		// the user do not count twice
+		var counters votesCounters
+		result := tx.Raw("SELECT upvotes, downvotes FROM "+t.counted+" WHERE id = ? FOR NO KEY UPDATE", id).Scan(&counters)
+		if result.Error != nil {
+			return result.Error
+		} else if result.RowsAffected == 0 {
+			return storage.ErrNotFound
+		}
+
+		var previous int8
+		err := tx.Raw("SELECT value FROM "+t.table+" WHERE "+t.column+" = ? AND user_id = ?", id, user_id).Scan(&previous).Error
+		if err != nil {
+			return err
+		}
+
+		votes = storage.Votes{Up: counters.Upvotes, Down: counters.Downvotes, Mine: vote}
+		if previous == vote {
+			return nil
+		}
+
+		if vote == 0 {
+			err = tx.Exec("DELETE FROM "+t.table+" WHERE "+t.column+" = ? AND user_id = ?", id, user_id).Error
+		} else {
+			err = tx.Exec("INSERT INTO "+t.table+" ("+t.column+", user_id, value) VALUES (?, ?, ?) "+
+				"ON CONFLICT ("+t.column+", user_id) DO UPDATE SET value = EXCLUDED.value, updated_at = now()", id, user_id, vote).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		up, down := votesDelta(previous, vote)
+		err = tx.Raw("UPDATE "+t.counted+" SET upvotes = upvotes + ?, downvotes = downvotes + ? WHERE id = ? RETURNING upvotes, downvotes",
+			up, down, id).Scan(&counters).Error
+		votes.Up, votes.Down = counters.Upvotes, counters.Downvotes
+		return err
+	})
+	return votes, err
+}
+
+// votesDelta returns changes of counters when the vote changes from previous.
+func votesDelta(previous int8, vote int8) (int64, int64) {
+	var up, down int64
+	switch previous {
+	case 1:
+		up--
+	case -1:
+		down--
+	}
+	switch vote {
+	case 1:
+		up++
+	case -1:
+		down++
+	}
+	return up, down
+}
+
+func (s *VoteStore) votes(ctx context.Context, t votesTable, ids []uint, user_id int64) ([]storage.Votes, error) {
+	votes := make([]storage.Votes, len(ids))
+	if len(ids) == 0 {
+		return votes, nil
+	}
+
+	var rows []struct {
+		ID uint
+		votesCounters
+	}
+	err := s.db.WithContext(ctx).Table(t.counted).Select("id", "upvotes", "downvotes").Where("id IN ?", ids).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	counters := make(map[uint]votesCounters, len(rows))
+	for _, row := range rows {
+		counters[row.ID] = row.votesCounters
+	}
+
+	mine := map[uint]int8{}
+	if user_id != 0 {
+		var user_votes []struct {
+			ID    uint
+			Value int8
+		}
+		err := s.db.WithContext(ctx).Table(t.table).Select(t.column+" AS id", "value").
+			Where("user_id = ? AND "+t.column+" IN ?", user_id, ids).Find(&user_votes).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, vote := range user_votes {
+			mine[vote.ID] = vote.Value
+		}
+	}
+
+	for i, id := range ids {
+		votes[i] = storage.Votes{Up: counters[id].Upvotes, Down: counters[id].Downvotes, Mine: mine[id]}
+	}
+	return votes, nil
+}
@@ -0,0 +1,228 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/pagination"
+	"go_1C/storage/redis/keys"
+)
+
+// Posts of authors with at most fanout_followers_limit followers are pushed
+// to timelines of followers on write. Posts of more popular authors are read
+// from postgres when the feed is requested.
+const fanout_followers_limit int64 = 1000
+
+// Number of the newest posts kept in a timeline.
+const timeline_size int64 = 1000
+
+func timelineScore(post *models.Post) float64 {
+	return float64(post.CreatedAt.UnixMicro())
+}
+
+// Posts are added only to existing timelines, missing timeline is rebuilt
+// from postgres on read and must not be created half-filled.
+var timelineAddScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[1], ARGV[2])
+redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -tonumber(ARGV[3]) - 1)
+return 1
+`)
+
+// FeedStore keeps redis timelines of posts and merges them with posts of
+// popular authors from postgres.
+type FeedStore struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewFeedStore(db *gorm.DB, rdb *redis.Client) *FeedStore {
+	return &FeedStore{db: db, rdb: rdb}
+}
+
+// popularAuthors selects authors whose posts are not fanned out.
+func (s *FeedStore) popularAuthors(ctx context.Context) *gorm.DB {
+	return s.db.WithContext(ctx).Model(&models.Follow{}).Select("followee_id").
+		Group("followee_id").Having("COUNT(*) > ?", fanout_followers_limit)
+}
+
+// fanoutFollowers returns followers of the author whose timelines keep the
+// author's posts, none for popular authors.
+func (s *FeedStore) fanoutFollowers(ctx context.Context, author_id uint) ([]uint, error) {
+	var followers int64
+	if err := s.db.WithContext(ctx).Model(&models.Follow{}).Where("followee_id = ?", author_id).Count(&followers).Error; err != nil {
+		return nil, err
+	}
+
+	if followers == 0 || followers > fanout_followers_limit {
+		return nil, nil
+	}
+
+	var follower_ids []uint
+	if err := s.db.WithContext(ctx).Model(&models.Follow{}).Where("followee_id = ?", author_id).Pluck("follower_id", &follower_ids).Error; err != nil {
+		return nil, err
+	}
+	return follower_ids, nil
+}
+
+// AddPost pushes new or restored post to timelines of author's followers.
+func (s *FeedStore) AddPost(ctx context.Context, post *models.Post) error {
+	follower_ids, err := s.fanoutFollowers(ctx, post.AuthorID)
+	if err != nil || len(follower_ids) == 0 {
+		return err
+	}
+
+	pipe := s.rdb.Pipeline()
+	for _, follower_id := range follower_ids {
+		timelineAddScript.Run(ctx, pipe, []string{keys.Timeline(follower_id)}, timelineScore(post), post.ID, timeline_size)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// RemovePost takes deleted post out of timelines of author's followers.
+// Timelines of users who unfollowed the author are reset, so they do not
+// keep the post either.
+func (s *FeedStore) RemovePost(ctx context.Context, post *models.Post) error {
+	follower_ids, err := s.fanoutFollowers(ctx, post.AuthorID)
+	if err != nil || len(follower_ids) == 0 {
+		return err
+	}
+
+	pipe := s.rdb.Pipeline()
+	for _, follower_id := range follower_ids {
+		pipe.ZRem(ctx, keys.Timeline(follower_id), post.ID)
+	}
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// rebuildTimeline fills timeline of the user from postgres if it is missing.
+func (s *FeedStore) rebuildTimeline(ctx context.Context, user_id uint) error {
+	exists, err := s.rdb.Exists(ctx, keys.Timeline(user_id)).Result()
+	if err != nil || exists > 0 {
+		return err
+	}
+
+	var posts []models.Post
+	if err := s.db.WithContext(ctx).Select("id", "created_at").
+		Where("author_id IN (?)", s.db.Model(&models.Follow{}).Select("followee_id").Where("follower_id = ?", user_id)).
+		Where("author_id NOT IN (?)", s.popularAuthors(ctx)).
+		Order("created_at DESC, id DESC").Limit(int(timeline_size)).Find(&posts).Error; err != nil {
+		return err
+	}
+
+	if len(posts) == 0 {
+		return nil
+	}
+
+	members := make([]redis.Z, len(posts))
+	for i := range posts {
+		members[i] = redis.Z{Score: timelineScore(&posts[i]), Member: posts[i].ID}
+	}
+	return s.rdb.ZAdd(ctx, keys.Timeline(user_id), members...).Err()
+}
+
+func (s *FeedStore) Feed(ctx context.Context, user_id uint, cursor *pagination.Cursor, limit int64) ([]models.Post, error) {
+	if err := s.rebuildTimeline(ctx, user_id); err != nil {
+		return nil, err
+	}
+
+	// fan-out-on-write part: post ids from the timeline
+	timeline_ids, err := s.timelineIDs(ctx, user_id, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// fan-out-on-read part: posts of popular followees are merged in the same query
+	followees := s.db.Model(&models.Follow{}).Select("followee_id").Where("follower_id = ?", user_id)
+	query := s.db.WithContext(ctx).Preload("Author").
+		Where(s.db.Where("id IN ?", timeline_ids).Or("author_id IN (?)", s.popularAuthors(ctx))).
+		Where("author_id IN (?)", followees).
+		Order("created_at DESC, id DESC").Limit(int(limit))
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	var posts []models.Post
+	if err := query.Find(&posts).Error; err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
+
+// timelineIDs reads ids of not deleted posts from the timeline, the newest
+// first, until limit of them are found or the timeline ends. Deleted posts
+// are normally removed from timelines by the outbox, but may still be there
+// until the event is applied.
+func (s *FeedStore) timelineIDs(ctx context.Context, user_id uint, cursor *pagination.Cursor, limit int64) ([]uint, error) {
+	// posts created in the same microsecond as the cursor are read too and
+	// filtered by postgres below
+	by_score := &redis.ZRangeBy{Min: "-inf", Max: "+inf", Count: limit + 10}
+	if cursor != nil {
+		by_score.Max = strconv.FormatInt(cursor.CreatedAt.UnixMicro(), 10)
+	}
+
+	timeline_ids := []uint{}
+	for {
+		members, err := s.rdb.ZRevRangeByScore(ctx, keys.Timeline(user_id), by_score).Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(members) == 0 {
+			return timeline_ids, nil
+		}
+
+		ids := make([]uint, 0, len(members))
+		for _, member := range members {
+			post_id, err := strconv.ParseUint(member, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			ids = append(ids, uint(post_id))
+		}
+
+		query := s.db.WithContext(ctx).Model(&models.Post{}).Where("id IN ?", ids)
+		if cursor != nil {
+			query = query.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+		}
+		var live []uint
+		if err := query.Pluck("id", &live).Error; err != nil {
+			return nil, err
+		}
+		timeline_ids = append(timeline_ids, live...)
+
+		if int64(len(timeline_ids)) >= limit || int64(len(members)) < by_score.Count {
+			return timeline_ids, nil
+		}
+		by_score.Offset += int64(len(members))
+	}
+}
+
+// Reset drops the timeline, it is rebuilt with posts of new followees on the
+// next read.
+func (s *FeedStore) Reset(ctx context.Context, user_id uint) error {
+	return s.rdb.Del(ctx, keys.Timeline(user_id)).Err()
+}
+
+// DropLegacyTimelines removes timelines under unversioned keys and returns
+// their number, timelines are rebuilt from postgres on read.
+func DropLegacyTimelines(ctx context.Context, rdb *redis.Client) (int, error) {
+	legacy, err := scanKeys(ctx, rdb, keys.LegacyTimelinePattern)
+	if err != nil {
+		return 0, err
+	}
+
+	for start := 0; start < len(legacy); start += warm_batch_size {
+		if err := rdb.Del(ctx, legacy[start:min(start+warm_batch_size, len(legacy))]...).Err(); err != nil {
+			return start, err
+		}
+	}
+	return len(legacy), nil
+}
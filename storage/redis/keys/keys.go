@@ -0,0 +1,105 @@
+// Package keys builds names of redis keys of the service. Keys are
+// namespaced and versioned, a new version of the format comes with moving of
+// stored data to new keys, see legacy.go.
+package keys
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	post_likes_prefix    = "likes:v2:post:"
+	comment_likes_prefix = "likes:v2:comment:"
+)
+
+// Patterns matching all keys of likes sets, for SCAN.
+const (
+	PostLikesPattern    = post_likes_prefix + "*"
+	CommentLikesPattern = comment_likes_prefix + "*"
+)
+
+// PostLikes is the set of ids of users who liked the post.
+func PostLikes(post_id uint) string {
+	return post_likes_prefix + strconv.FormatUint(uint64(post_id), 10)
+}
+
+// CommentLikes is the set of ids of users who liked the comment.
+func CommentLikes(comment_id uint) string {
+	return comment_likes_prefix + strconv.FormatUint(uint64(comment_id), 10)
+}
+
+// PostLikesVersion counts changes of likes of the post, a set loaded from
+// postgres is cached only if no change happened during the load.
+func PostLikesVersion(post_id uint) string {
+	return "likes:v2:version:post:" + strconv.FormatUint(uint64(post_id), 10)
+}
+
+// CommentLikesVersion counts changes of likes of the comment.
+func CommentLikesVersion(comment_id uint) string {
+	return "likes:v2:version:comment:" + strconv.FormatUint(uint64(comment_id), 10)
+}
+
+// ParsePostLikes returns id of the post of PostLikes key.
+func ParsePostLikes(key string) (uint, bool) {
+	return parseID(key, post_likes_prefix)
+}
+
+// ParseCommentLikes returns id of the comment of CommentLikes key.
+func ParseCommentLikes(key string) (uint, bool) {
+	return parseID(key, comment_likes_prefix)
+}
+
+func parseID(key string, prefix string) (uint, bool) {
+	encoded, ok := strings.CutPrefix(key, prefix)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(encoded, 10, 64)
+	return uint(id), err == nil
+}
+
+// PostReactions is the hash of numbers of users by reaction to the post.
+func PostReactions(post_id uint) string {
+	return "reactions:v1:post:" + strconv.FormatUint(uint64(post_id), 10)
+}
+
+// PostReactionUsers is the hash of reactions to the post by user id.
+func PostReactionUsers(post_id uint) string {
+	return PostReactions(post_id) + ":users"
+}
+
+// PostReactionsVersion counts changes of reactions to the post, see
+// PostLikesVersion.
+func PostReactionsVersion(post_id uint) string {
+	return PostReactions(post_id) + ":version"
+}
+
+func CommentReactions(comment_id uint) string {
+	return "reactions:v1:comment:" + strconv.FormatUint(uint64(comment_id), 10)
+}
+
+func CommentReactionUsers(comment_id uint) string {
+	return CommentReactions(comment_id) + ":users"
+}
+
+func CommentReactionsVersion(comment_id uint) string {
+	return CommentReactions(comment_id) + ":version"
+}
+
+// Timeline is the sorted set of post ids of the user's feed scored by post
+// creation time.
+func Timeline(user_id uint) string {
+	return "feed:v1:timeline:" + strconv.FormatUint(uint64(user_id), 10)
+}
+
+// RefreshToken maps the refresh token to its user.
+func RefreshToken(token string) string {
+	return "sessions:v1:refresh_token:" + token
+}
+
+// UserRefreshTokens is the set of refresh tokens of the user, some of them
+// may be expired already.
+func UserRefreshTokens(user_id int64) string {
+	return "sessions:v1:user_refresh_tokens:" + strconv.FormatInt(user_id, 10)
+}
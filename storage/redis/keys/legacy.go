@@ -0,0 +1,59 @@
+package keys
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Version 1 keys of likes, they are only read to move likes to version 2.
+// Id was converted to a rune instead of its decimal form, so ids which are
+// not valid code points all share the key with the replacement character.
+const (
+	legacy_post_likes_prefix    = "post_"
+	legacy_comment_likes_prefix = "comment_"
+)
+
+const (
+	LegacyPostLikesPattern    = legacy_post_likes_prefix + "*"
+	LegacyCommentLikesPattern = legacy_comment_likes_prefix + "*"
+	LegacyPostsRating         = "posts_likes_rating"
+)
+
+// Unversioned keys of timelines and refresh tokens. Timelines are rebuilt on
+// read, so they are dropped, refresh tokens are moved.
+const (
+	LegacyTimelinePattern       = "timeline_*"
+	legacy_refresh_token_prefix = "refresh_token_"
+	LegacyRefreshTokenPattern   = legacy_refresh_token_prefix + "*"
+)
+
+// ParseLegacyRefreshToken returns the token of unversioned refresh token key.
+func ParseLegacyRefreshToken(key string) (string, bool) {
+	return strings.CutPrefix(key, legacy_refresh_token_prefix)
+}
+
+// Ratings of posts by likes of both versions, posts are ordered by likes in
+// postgres now.
+var LegacyRatings = []string{LegacyPostsRating, "likes:v2:rating:posts"}
+
+// ParseLegacyPostLikes returns id of the post of version 1 key. It fails for
+// keys shared by several ids.
+func ParseLegacyPostLikes(key string) (uint, bool) {
+	return parseLegacyID(key, legacy_post_likes_prefix)
+}
+
+func ParseLegacyCommentLikes(key string) (uint, bool) {
+	return parseLegacyID(key, legacy_comment_likes_prefix)
+}
+
+func parseLegacyID(key string, prefix string) (uint, bool) {
+	encoded, ok := strings.CutPrefix(key, prefix)
+	if !ok {
+		return 0, false
+	}
+	r, size := utf8.DecodeRuneInString(encoded)
+	if r == utf8.RuneError || size != len(encoded) {
+		return 0, false
+	}
+	return uint(r), true
+}
@@ -0,0 +1,406 @@
+// Package redis implements likes, sessions and feed stores on top of redis.
+package redis
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/storage"
+	"go_1C/storage/redis/keys"
+)
+
+// Cached set of likes holds the marker besides ids of users who liked, so
+// the set of a post without likes is not dropped by redis. The set exists
+// if and only if it is cached.
+const cached_marker = "-"
+
+// Cached sets expire, so sets of rarely read rows do not stay in memory.
+// Versions of sets live as long, a version expired during a load only makes
+// the loader skip caching.
+const likes_cache_ttl = 24 * time.Hour
+
+// Set is filled only if it is not cached yet, a concurrent loader may have
+// filled it already, and only if the version read before the load from
+// postgres did not change, otherwise a change committed during the load
+// would be missing from the cache. ARGV: ttl in seconds, the version or ""
+// if there was none, then members.
+var likesLoadScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+if (redis.call("GET", KEYS[2]) or "") ~= ARGV[2] then
+	return 0
+end
+for i = 3, #ARGV, 1000 do
+	redis.call("SADD", KEYS[1], unpack(ARGV, i, math.min(i + 999, #ARGV)))
+end
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+return 1
+`)
+
+// Every change bumps the version, then it is written to the cached set,
+// missing set is loaded on read. ARGV: "1" to add or "0" to remove the user,
+// id of the user, then ttl of the version in seconds.
+var likesChangeScript = redis.NewScript(`
+redis.call("INCR", KEYS[2])
+redis.call("EXPIRE", KEYS[2], ARGV[3])
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+if ARGV[1] == "1" then
+	redis.call("SADD", KEYS[1], ARGV[2])
+else
+	redis.call("SREM", KEYS[1], ARGV[2])
+end
+return 1
+`)
+
+// likesTable describes where likes of posts or comments are kept.
+type likesTable struct {
+	// table of likes and its column referencing the liked row
+	table  string
+	column string
+	// table of liked rows with likes_count column
+	counted string
+	// keys of cached sets, see package keys
+	pattern string
+	key     func(id uint) string
+	parse   func(key string) (uint, bool)
+	version func(id uint) string
+	// outbox event to refresh cache which missed a change
+	stale string
+}
+
+var (
+	post_likes = likesTable{
+		table:   "post_likes",
+		column:  "post_id",
+		counted: "posts",
+		pattern: keys.PostLikesPattern,
+		key:     keys.PostLikes,
+		parse:   keys.ParsePostLikes,
+		version: keys.PostLikesVersion,
+		stale:   storage.EventPostLikesStale,
+	}
+	comment_likes = likesTable{
+		table:   "comment_likes",
+		column:  "comment_id",
+		counted: "comments",
+		pattern: keys.CommentLikesPattern,
+		key:     keys.CommentLikes,
+		parse:   keys.ParseCommentLikes,
+		version: keys.CommentLikesVersion,
+		stale:   storage.EventCommentLikesStale,
+	}
+)
+
+// LikeStore keeps likes in postgres and caches them in redis. Writes go to
+// postgres first and then to the cache, reads are served by the cache and
+// load missing sets from postgres.
+type LikeStore struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewLikeStore(db *gorm.DB, rdb *redis.Client) *LikeStore {
+	return &LikeStore{db: db, rdb: rdb}
+}
+
+func (s *LikeStore) LikePost(ctx context.Context, post_id uint, user_id int64) (bool, error) {
+	return s.change(ctx, post_likes, post_id, user_id, true)
+}
+
+func (s *LikeStore) UnlikePost(ctx context.Context, post_id uint, user_id int64) (bool, error) {
+	return s.change(ctx, post_likes, post_id, user_id, false)
+}
+
+func (s *LikeStore) LikeComment(ctx context.Context, comment_id uint, user_id int64) (bool, error) {
+	return s.change(ctx, comment_likes, comment_id, user_id, true)
+}
+
+func (s *LikeStore) UnlikeComment(ctx context.Context, comment_id uint, user_id int64) (bool, error) {
+	return s.change(ctx, comment_likes, comment_id, user_id, false)
+}
+
+// change saves the like or removes it together with its counter, then
+// writes the change through to the cache.
+func (s *LikeStore) change(ctx context.Context, t likesTable, id uint, user_id int64, like bool) (bool, error) {
+	changed := false
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query, delta := "INSERT INTO "+t.table+" ("+t.column+", user_id) VALUES (?, ?) ON CONFLICT DO NOTHING", 1
+		if !like {
+			query, delta = "DELETE FROM "+t.table+" WHERE "+t.column+" = ? AND user_id = ?", -1
+		}
+
+		result := tx.Exec(query, id, user_id)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		changed = true
+
+		return tx.Exec("UPDATE "+t.counted+" SET likes_count = likes_count + ? WHERE id = ?", delta, id).Error
+	})
+	if err != nil || !changed {
+		return false, err
+	}
+
+	if err := s.writeThrough(ctx, t, id, user_id, like); err != nil {
+		// the change is saved, the cache is fixed by the outbox worker
+		if err := s.db.WithContext(ctx).Create(&models.OutboxEvent{Kind: t.stale, EntityID: id}).Error; err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func (s *LikeStore) writeThrough(ctx context.Context, t likesTable, id uint, user_id int64, like bool) error {
+	add := "0"
+	if like {
+		add = "1"
+	}
+	return likesChangeScript.Run(ctx, s.rdb, []string{t.key(id), t.version(id)}, add, user_id, int64(likes_cache_ttl.Seconds())).Err()
+}
+
+func (s *LikeStore) PostLikes(ctx context.Context, post_id uint, user_id int64) (int64, bool, error) {
+	likes, err := s.batch(ctx, post_likes, []uint{post_id}, user_id)
+	if err != nil {
+		return 0, false, err
+	}
+	return likes[0].Count, likes[0].Liked, nil
+}
+
+func (s *LikeStore) CommentLikes(ctx context.Context, comment_id uint, user_id int64) (int64, bool, error) {
+	likes, err := s.batch(ctx, comment_likes, []uint{comment_id}, user_id)
+	if err != nil {
+		return 0, false, err
+	}
+	return likes[0].Count, likes[0].Liked, nil
+}
+
+func (s *LikeStore) PostsLikes(ctx context.Context, post_ids []uint, user_id int64) ([]storage.Likes, error) {
+	return s.batch(ctx, post_likes, post_ids, user_id)
+}
+
+func (s *LikeStore) CommentsLikes(ctx context.Context, comment_ids []uint, user_id int64) ([]storage.Likes, error) {
+	return s.batch(ctx, comment_likes, comment_ids, user_id)
+}
+
+// batch reads likes of all ids in one pipeline, sets missing in the cache
+// are loaded from postgres at once.
+func (s *LikeStore) batch(ctx context.Context, t likesTable, ids []uint, user_id int64) ([]storage.Likes, error) {
+	likes := make([]storage.Likes, len(ids))
+	if len(ids) == 0 {
+		return likes, nil
+	}
+
+	pipe := s.rdb.Pipeline()
+	card := make([]*redis.IntCmd, len(ids))
+	is_member := make([]*redis.BoolCmd, len(ids))
+	for i, id := range ids {
+		card[i] = pipe.SCard(ctx, t.key(id))
+		if user_id != 0 {
+			is_member[i] = pipe.SIsMember(ctx, t.key(id), user_id)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	var missed []uint
+	for i, id := range ids {
+		if card[i].Val() == 0 {
+			missed = append(missed, id)
+			continue
+		}
+		likes[i] = storage.Likes{Count: card[i].Val() - 1, Liked: user_id != 0 && is_member[i].Val()}
+	}
+	if len(missed) == 0 {
+		return likes, nil
+	}
+
+	users, err := s.load(ctx, t, missed)
+	if err != nil {
+		return nil, err
+	}
+	for i, id := range ids {
+		if card[i].Val() == 0 {
+			likes[i] = storage.Likes{Count: int64(len(users[id])), Liked: slices.Contains(users[id], user_id)}
+		}
+	}
+	return likes, nil
+}
+
+// load reads likes of ids from postgres and caches them. Versions are read
+// first, so sets changed during the load are not cached.
+func (s *LikeStore) load(ctx context.Context, t likesTable, ids []uint) (map[uint][]int64, error) {
+	version_keys := make([]string, len(ids))
+	for i, id := range ids {
+		version_keys[i] = t.version(id)
+	}
+	versions, err := s.rdb.MGet(ctx, version_keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		ID     uint
+		UserID int64
+	}
+	err = s.db.WithContext(ctx).Table(t.table).Select(t.column+" AS id", "user_id").
+		Where(t.column+" IN ?", ids).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[uint][]int64, len(ids))
+	for _, row := range rows {
+		users[row.ID] = append(users[row.ID], row.UserID)
+	}
+
+	pipe := s.rdb.Pipeline()
+	for i, id := range ids {
+		version, _ := versions[i].(string)
+		args := make([]any, 0, len(users[id])+3)
+		args = append(args, int64(likes_cache_ttl.Seconds()), version, cached_marker)
+		for _, user_id := range users[id] {
+			args = append(args, user_id)
+		}
+		likesLoadScript.Eval(ctx, pipe, []string{t.key(id), t.version(id)}, args...)
+	}
+	_, err = pipe.Exec(ctx)
+	return users, err
+}
+
+func (s *LikeStore) LikedPosts(ctx context.Context, post_ids []uint, user_id int64) ([]bool, error) {
+	likes, err := s.batch(ctx, post_likes, post_ids, user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	liked := make([]bool, len(likes))
+	for i := range likes {
+		liked[i] = likes[i].Liked
+	}
+	return liked, nil
+}
+
+func (s *LikeStore) PostLikers(ctx context.Context, post_id uint, offset int64, limit int64) ([]uint, error) {
+	return s.likers(ctx, post_likes, post_id, offset, limit)
+}
+
+func (s *LikeStore) CommentLikers(ctx context.Context, comment_id uint, offset int64, limit int64) ([]uint, error) {
+	return s.likers(ctx, comment_likes, comment_id, offset, limit)
+}
+
+// likers are read from postgres, cached sets are not ordered.
+func (s *LikeStore) likers(ctx context.Context, t likesTable, id uint, offset int64, limit int64) ([]uint, error) {
+	user_ids := []uint{}
+	err := s.db.WithContext(ctx).Table(t.table).Where(t.column+" = ?", id).
+		Order("created_at DESC, user_id DESC").Offset(int(offset)).Limit(int(limit)).Pluck("user_id", &user_ids).Error
+	return user_ids, err
+}
+
+func (s *LikeStore) LatestPostLikers(ctx context.Context, post_ids []uint, user_ids []uint) ([]uint, error) {
+	latest := make([]uint, len(post_ids))
+	if len(post_ids) == 0 || len(user_ids) == 0 {
+		return latest, nil
+	}
+
+	var rows []struct {
+		PostID uint
+		UserID uint
+	}
+	err := s.db.WithContext(ctx).Table("post_likes").Select("DISTINCT ON (post_id) post_id, user_id").
+		Where("post_id IN ? AND user_id IN ?", post_ids, user_ids).
+		Order("post_id, created_at DESC, user_id DESC").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	likers := make(map[uint]uint, len(rows))
+	for _, row := range rows {
+		likers[row.PostID] = row.UserID
+	}
+	for i, post_id := range post_ids {
+		latest[i] = likers[post_id]
+	}
+	return latest, nil
+}
+
+// RefreshPostLikes drops cached likes, they are loaded again on read.
+func (s *LikeStore) RefreshPostLikes(ctx context.Context, post_id uint) error {
+	return s.invalidate(ctx, post_likes, post_id)
+}
+
+func (s *LikeStore) RefreshCommentLikes(ctx context.Context, comment_id uint) error {
+	return s.invalidate(ctx, comment_likes, comment_id)
+}
+
+// invalidate drops the cached set and bumps its version, so a load started
+// before the change missed by the cache does not fill the set again.
+func (s *LikeStore) invalidate(ctx context.Context, t likesTable, id uint) error {
+	pipe := s.rdb.TxPipeline()
+	pipe.Incr(ctx, t.version(id))
+	pipe.Expire(ctx, t.version(id), likes_cache_ttl)
+	pipe.Del(ctx, t.key(id))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *LikeStore) LikedPostIDs(ctx context.Context) ([]uint, error) {
+	return s.scanIDs(ctx, post_likes)
+}
+
+func (s *LikeStore) LikedCommentIDs(ctx context.Context) ([]uint, error) {
+	return s.scanIDs(ctx, comment_likes)
+}
+
+// scanIDs walks keys of cached likes sets without blocking redis.
+func (s *LikeStore) scanIDs(ctx context.Context, t likesTable) ([]uint, error) {
+	ids := []uint{}
+	iter := s.rdb.Scan(ctx, 0, t.pattern, 1000).Iterator()
+	for iter.Next(ctx) {
+		if id, ok := t.parse(iter.Val()); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, iter.Err()
+}
+
+// DeletePostLikes drops cached likes, likes in postgres are removed by
+// cascade together with the post.
+func (s *LikeStore) DeletePostLikes(ctx context.Context, post_ids []uint) error {
+	if len(post_ids) == 0 {
+		return nil
+	}
+
+	cached := make([]string, len(post_ids))
+	for i, post_id := range post_ids {
+		cached[i] = keys.PostLikes(post_id)
+	}
+	return s.rdb.Del(ctx, cached...).Err()
+}
+
+func (s *LikeStore) DeleteCommentLikes(ctx context.Context, comment_ids []uint) error {
+	if len(comment_ids) == 0 {
+		return nil
+	}
+
+	cached := make([]string, len(comment_ids))
+	for i, comment_id := range comment_ids {
+		cached[i] = keys.CommentLikes(comment_id)
+	}
+	return s.rdb.Del(ctx, cached...).Err()
+}
+
+var (
+	_ storage.LikeStore     = (*LikeStore)(nil)
+	_ storage.ReactionStore = (*ReactionStore)(nil)
+	_ storage.SessionStore  = (*SessionStore)(nil)
+	_ storage.FeedStore     = (*FeedStore)(nil)
+)
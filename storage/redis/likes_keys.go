@@ -0,0 +1,103 @@
+package redis
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+
+	"go_1C/storage/redis/keys"
+)
+
+// Legacy set is merged into the new one, so likes written to the new key
+// meanwhile are kept, and the legacy key is removed only after that.
+var likesMoveScript = redis.NewScript(`
+if redis.call("TYPE", KEYS[1]).ok ~= "set" then
+	return 0
+end
+redis.call("SUNIONSTORE", KEYS[2], KEYS[2], KEYS[1])
+redis.call("DEL", KEYS[1])
+return 1
+`)
+
+// LikesKeysMigration counts keys handled by MigrateLikesKeys.
+type LikesKeysMigration struct {
+	Moved int
+	// legacy keys shared by several ids, they are left as is
+	Ambiguous int
+}
+
+// MigrateLikesKeys moves likes sets from version 1 keys to keys of package
+// keys. It is safe to run it again, e.g. after it was interrupted. Legacy
+// ratings are dropped, they are not used anymore.
+func MigrateLikesKeys(ctx context.Context, rdb *redis.Client) (LikesKeysMigration, error) {
+	var migration LikesKeysMigration
+	tables := []struct {
+		pattern string
+		parse   func(key string) (uint, bool)
+		key     func(id uint) string
+	}{
+		{keys.LegacyPostLikesPattern, keys.ParseLegacyPostLikes, keys.PostLikes},
+		{keys.LegacyCommentLikesPattern, keys.ParseLegacyCommentLikes, keys.CommentLikes},
+	}
+
+	for _, t := range tables {
+		legacy, err := scanKeys(ctx, rdb, t.pattern)
+		if err != nil {
+			return migration, err
+		}
+
+		for start := 0; start < len(legacy); start += warm_batch_size {
+			pipe := rdb.Pipeline()
+			var moved []*redis.Cmd
+			for _, key := range legacy[start:min(start+warm_batch_size, len(legacy))] {
+				id, ok := t.parse(key)
+				if !ok {
+					migration.Ambiguous++
+					continue
+				}
+				moved = append(moved, likesMoveScript.Eval(ctx, pipe, []string{key, t.key(id)}))
+			}
+			if len(moved) == 0 {
+				continue
+			}
+			if _, err := pipe.Exec(ctx); err != nil {
+				return migration, err
+			}
+			for _, cmd := range moved {
+				if cmd.Val() == int64(1) {
+					migration.Moved++
+				}
+			}
+		}
+	}
+
+	return migration, rdb.Del(ctx, keys.LegacyRatings...).Err()
+}
+
+// scanKeys returns keys matching the pattern without blocking redis.
+func scanKeys(ctx context.Context, rdb *redis.Client, pattern string) ([]string, error) {
+	var found []string
+	iter := rdb.Scan(ctx, 0, pattern, 1000).Iterator()
+	for iter.Next(ctx) {
+		found = append(found, iter.Val())
+	}
+	return found, iter.Err()
+}
+
+// hasLegacyLikes reports whether likes sets are left under version 1 keys.
+func hasLegacyLikes(ctx context.Context, rdb *redis.Client) (bool, error) {
+	for _, pattern := range []string{keys.LegacyPostLikesPattern, keys.LegacyCommentLikesPattern} {
+		iter := rdb.Scan(ctx, 0, pattern, 1000).Iterator()
+		for iter.Next(ctx) {
+			_, post := keys.ParseLegacyPostLikes(iter.Val())
+			_, comment := keys.ParseLegacyCommentLikes(iter.Val())
+			if post || comment {
+				return true, nil
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,163 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm/clause"
+)
+
+// Number of rows or keys handled at once by Warm.
+const warm_batch_size = 1000
+
+// Warm prepares the cache on start. Likes kept only in redis by previous
+// versions are imported to postgres once, counters are repaired after likes
+// removed by cascades, then cached sets are rebuilt from postgres. Sets under legacy keys must be moved by MigrateLikesKeys first,
+// otherwise they would be lost by the import.
+func (s *LikeStore) Warm(ctx context.Context) error {
+	legacy, err := hasLegacyLikes(ctx, s.rdb)
+	if err != nil {
+		return err
+	}
+	if legacy {
+		return errors.New("likes are stored under legacy redis keys, run migrate-redis-keys first")
+	}
+
+	for _, t := range []likesTable{post_likes, comment_likes} {
+		if err := s.importLegacy(ctx, t); err != nil {
+			return err
+		}
+		if err := s.repairCounters(ctx, t); err != nil {
+			return err
+		}
+		if err := s.rebuildCache(ctx, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importLegacy copies likes from redis sets to the empty likes table. Likes
+// of missing rows or users are skipped.
+func (s *LikeStore) importLegacy(ctx context.Context, t likesTable) error {
+	var imported bool
+	if err := s.db.WithContext(ctx).Raw("SELECT EXISTS (SELECT 1 FROM " + t.table + ")").Scan(&imported).Error; err != nil || imported {
+		return err
+	}
+
+	ids, err := s.scanIDs(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(ids); start += warm_batch_size {
+		batch := ids[start:min(start+warm_batch_size, len(ids))]
+
+		pipe := s.rdb.Pipeline()
+		members := make([]*redis.StringSliceCmd, len(batch))
+		for i, id := range batch {
+			members[i] = pipe.SMembers(ctx, t.key(id))
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return err
+		}
+
+		var existing []uint
+		if err := s.db.WithContext(ctx).Table(t.counted).Where("id IN ?", batch).Pluck("id", &existing).Error; err != nil {
+			return err
+		}
+
+		var rows []map[string]any
+		var user_ids []int64
+		for i, id := range batch {
+			for _, member := range members[i].Val() {
+				user_id, err := strconv.ParseInt(member, 10, 64)
+				if err != nil {
+					continue
+				}
+				rows = append(rows, map[string]any{t.column: id, "user_id": user_id})
+				user_ids = append(user_ids, user_id)
+			}
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		var users []int64
+		if err := s.db.WithContext(ctx).Table("users").Where("id IN ?", user_ids).Pluck("id", &users).Error; err != nil {
+			return err
+		}
+
+		rows = filterRows(rows, t.column, existing, users)
+		if len(rows) == 0 {
+			continue
+		}
+		if err := s.db.WithContext(ctx).Table(t.table).Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(rows, warm_batch_size).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterRows keeps likes of existing rows by existing users.
+func filterRows(rows []map[string]any, column string, ids []uint, user_ids []int64) []map[string]any {
+	found_ids := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		found_ids[id] = true
+	}
+	found_users := make(map[int64]bool, len(user_ids))
+	for _, user_id := range user_ids {
+		found_users[user_id] = true
+	}
+
+	filtered := rows[:0]
+	for _, row := range rows {
+		if found_ids[row[column].(uint)] && found_users[row["user_id"].(int64)] {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered
+}
+
+// repairCounters sets likes_count to the number of likes where they differ,
+// e.g. after likes were imported or removed by cascade with their users.
+func (s *LikeStore) repairCounters(ctx context.Context, t likesTable) error {
+	return s.db.WithContext(ctx).Exec(`UPDATE ` + t.counted + ` SET likes_count = counted.likes
+		FROM (
+			SELECT rows.id, COUNT(likes.user_id) AS likes
+			FROM ` + t.counted + ` AS rows LEFT JOIN ` + t.table + ` AS likes ON likes.` + t.column + ` = rows.id
+			GROUP BY rows.id
+		) AS counted
+		WHERE ` + t.counted + `.id = counted.id AND ` + t.counted + `.likes_count <> counted.likes`).Error
+}
+
+// rebuildCache drops cached sets and loads sets of liked rows again.
+func (s *LikeStore) rebuildCache(ctx context.Context, t likesTable) error {
+	cached, err := s.scanIDs(ctx, t)
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(cached); start += warm_batch_size {
+		batch := cached[start:min(start+warm_batch_size, len(cached))]
+		batch_keys := make([]string, len(batch))
+		for i, id := range batch {
+			batch_keys[i] = t.key(id)
+		}
+		if err := s.rdb.Unlink(ctx, batch_keys...).Err(); err != nil {
+			return err
+		}
+	}
+
+	var liked []uint
+	if err := s.db.WithContext(ctx).Table(t.counted).Where("likes_count > 0").Order("id").Pluck("id", &liked).Error; err != nil {
+		return err
+	}
+	for start := 0; start < len(liked); start += warm_batch_size {
+		if _, err := s.load(ctx, t, liked[start:min(start+warm_batch_size, len(liked))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
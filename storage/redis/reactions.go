@@ -0,0 +1,314 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+
+	"go_1C/models"
+	"go_1C/storage"
+	"go_1C/storage/redis/keys"
+)
+
+// Cached reactions are two hashes: numbers of users by reaction and
+// reactions separated by spaces by user id. Both hold cached_marker field,
+// so they are not dropped by redis when empty, and are cached if and only if
+// both exist.
+//
+// Hashes are filled only if they are not cached yet and the version did not
+// change during the load, as likes sets are. ARGV: ttl in seconds, the
+// version or "", number n of the following arguments with counts, n
+// arguments with pairs of reaction and count, then pairs of user id and
+// reactions.
+var reactionsLoadScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1], KEYS[2]) == 2 then
+	return 0
+end
+if (redis.call("GET", KEYS[3]) or "") ~= ARGV[2] then
+	return 0
+end
+redis.call("DEL", KEYS[1], KEYS[2])
+local counts = tonumber(ARGV[3])
+redis.call("HSET", KEYS[1], "-", 0)
+for i = 4, 3 + counts, 2 do
+	redis.call("HSET", KEYS[1], ARGV[i], ARGV[i + 1])
+end
+redis.call("HSET", KEYS[2], "-", "")
+for i = 4 + counts, #ARGV, 2 do
+	redis.call("HSET", KEYS[2], ARGV[i], ARGV[i + 1])
+end
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+redis.call("EXPIRE", KEYS[2], ARGV[1])
+return 1
+`)
+
+// Every change bumps the version, then it is written only to cached hashes,
+// missing ones are loaded on read. ARGV: "1" to add or "0" to remove the
+// reaction, id of the user, reaction, then ttl of the version in seconds.
+var reactionsChangeScript = redis.NewScript(`
+redis.call("INCR", KEYS[3])
+redis.call("EXPIRE", KEYS[3], ARGV[4])
+if redis.call("EXISTS", KEYS[1], KEYS[2]) < 2 then
+	return 0
+end
+local mine = {}
+local found = false
+for reaction in string.gmatch(redis.call("HGET", KEYS[2], ARGV[2]) or "", "%S+") do
+	if reaction == ARGV[3] then
+		found = true
+	else
+		table.insert(mine, reaction)
+	end
+end
+if ARGV[1] == "1" then
+	if found then
+		return 1
+	end
+	table.insert(mine, ARGV[3])
+	redis.call("HINCRBY", KEYS[1], ARGV[3], 1)
+else
+	if not found then
+		return 1
+	end
+	redis.call("HINCRBY", KEYS[1], ARGV[3], -1)
+end
+if #mine == 0 then
+	redis.call("HDEL", KEYS[2], ARGV[2])
+else
+	redis.call("HSET", KEYS[2], ARGV[2], table.concat(mine, " "))
+end
+return 1
+`)
+
+// reactionsTable describes where reactions of posts or comments are kept.
+type reactionsTable struct {
+	// table of reactions and its column referencing the reacted row
+	table   string
+	column  string
+	counts  func(id uint) string
+	users   func(id uint) string
+	version func(id uint) string
+	// outbox event to refresh cache which missed a change
+	stale string
+}
+
+var (
+	post_reactions = reactionsTable{
+		table:   "post_reactions",
+		column:  "post_id",
+		counts:  keys.PostReactions,
+		users:   keys.PostReactionUsers,
+		version: keys.PostReactionsVersion,
+		stale:   storage.EventPostReactionsStale,
+	}
+	comment_reactions = reactionsTable{
+		table:   "comment_reactions",
+		column:  "comment_id",
+		counts:  keys.CommentReactions,
+		users:   keys.CommentReactionUsers,
+		version: keys.CommentReactionsVersion,
+		stale:   storage.EventCommentReactionsStale,
+	}
+)
+
+// ReactionStore keeps reactions in postgres and caches them in redis the
+// same way LikeStore does with likes.
+type ReactionStore struct {
+	db  *gorm.DB
+	rdb *redis.Client
+}
+
+func NewReactionStore(db *gorm.DB, rdb *redis.Client) *ReactionStore {
+	return &ReactionStore{db: db, rdb: rdb}
+}
+
+func (s *ReactionStore) ReactPost(ctx context.Context, post_id uint, user_id int64, reaction string) (bool, error) {
+	return s.change(ctx, post_reactions, post_id, user_id, reaction, true)
+}
+
+func (s *ReactionStore) UnreactPost(ctx context.Context, post_id uint, user_id int64, reaction string) (bool, error) {
+	return s.change(ctx, post_reactions, post_id, user_id, reaction, false)
+}
+
+func (s *ReactionStore) PostReactions(ctx context.Context, post_ids []uint, user_id int64) ([]storage.Reactions, error) {
+	return s.reactions(ctx, post_reactions, post_ids, user_id)
+}
+
+func (s *ReactionStore) ReactComment(ctx context.Context, comment_id uint, user_id int64, reaction string) (bool, error) {
+	return s.change(ctx, comment_reactions, comment_id, user_id, reaction, true)
+}
+
+func (s *ReactionStore) UnreactComment(ctx context.Context, comment_id uint, user_id int64, reaction string) (bool, error) {
+	return s.change(ctx, comment_reactions, comment_id, user_id, reaction, false)
+}
+
+func (s *ReactionStore) CommentReactions(ctx context.Context, comment_ids []uint, user_id int64) ([]storage.Reactions, error) {
+	return s.reactions(ctx, comment_reactions, comment_ids, user_id)
+}
+
+// RefreshPostReactions drops cached reactions, they are loaded again on read.
+func (s *ReactionStore) RefreshPostReactions(ctx context.Context, post_id uint) error {
+	return s.invalidate(ctx, post_reactions, post_id)
+}
+
+func (s *ReactionStore) RefreshCommentReactions(ctx context.Context, comment_id uint) error {
+	return s.invalidate(ctx, comment_reactions, comment_id)
+}
+
+// invalidate drops cached hashes and bumps their version, see
+// LikeStore.invalidate.
+func (s *ReactionStore) invalidate(ctx context.Context, t reactionsTable, id uint) error {
+	pipe := s.rdb.TxPipeline()
+	pipe.Incr(ctx, t.version(id))
+	pipe.Expire(ctx, t.version(id), likes_cache_ttl)
+	pipe.Del(ctx, t.counts(id), t.users(id))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// change saves the reaction or removes it, then writes the change through
+// to the cache.
+func (s *ReactionStore) change(ctx context.Context, t reactionsTable, id uint, user_id int64, reaction string, react bool) (bool, error) {
+	query, add := "INSERT INTO "+t.table+" ("+t.column+", user_id, reaction) VALUES (?, ?, ?) ON CONFLICT DO NOTHING", "1"
+	if !react {
+		query, add = "DELETE FROM "+t.table+" WHERE "+t.column+" = ? AND user_id = ? AND reaction = ?", "0"
+	}
+
+	result := s.db.WithContext(ctx).Exec(query, id, user_id, reaction)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+
+	err := reactionsChangeScript.Run(ctx, s.rdb, []string{t.counts(id), t.users(id), t.version(id)}, add, user_id, reaction, int64(likes_cache_ttl.Seconds())).Err()
+	if err != nil {
+		// the change is saved, the cache is fixed by the outbox worker
+		if err := s.db.WithContext(ctx).Create(&models.OutboxEvent{Kind: t.stale, EntityID: id}).Error; err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func (s *ReactionStore) reactions(ctx context.Context, t reactionsTable, ids []uint, user_id int64) ([]storage.Reactions, error) {
+	result := make([]storage.Reactions, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	pipe := s.rdb.Pipeline()
+	cached := make([]*redis.IntCmd, len(ids))
+	counts := make([]*redis.MapStringStringCmd, len(ids))
+	mine := make([]*redis.SliceCmd, len(ids))
+	for i, id := range ids {
+		cached[i] = pipe.Exists(ctx, t.counts(id), t.users(id))
+		counts[i] = pipe.HGetAll(ctx, t.counts(id))
+		mine[i] = pipe.HMGet(ctx, t.users(id), strconv.FormatInt(user_id, 10))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	var missed []uint
+	for i, id := range ids {
+		if cached[i].Val() < 2 {
+			missed = append(missed, id)
+			continue
+		}
+
+		result[i].Counts = map[string]int64{}
+		for reaction, count := range counts[i].Val() {
+			if n, err := strconv.ParseInt(count, 10, 64); err == nil && n > 0 && reaction != cached_marker {
+				result[i].Counts[reaction] = n
+			}
+		}
+		if user_id != 0 {
+			if reactions, ok := mine[i].Val()[0].(string); ok {
+				result[i].Mine = strings.Fields(reactions)
+			}
+		}
+	}
+	if len(missed) == 0 {
+		return result, nil
+	}
+
+	loaded, err := s.load(ctx, t, missed)
+	if err != nil {
+		return nil, err
+	}
+	for i, id := range ids {
+		if cached[i].Val() < 2 {
+			result[i] = loaded[id].of(user_id)
+		}
+	}
+	return result, nil
+}
+
+// loadedReactions are reactions of one row read from postgres.
+type loadedReactions struct {
+	counts map[string]int64
+	users  map[int64][]string
+}
+
+func (r loadedReactions) of(user_id int64) storage.Reactions {
+	counts := make(map[string]int64, len(r.counts))
+	for reaction, count := range r.counts {
+		counts[reaction] = count
+	}
+	var mine []string
+	if user_id != 0 {
+		mine = r.users[user_id]
+	}
+	return storage.Reactions{Counts: counts, Mine: mine}
+}
+
+// load reads reactions of ids from postgres and caches them. Versions are
+// read first, so hashes changed during the load are not cached.
+func (s *ReactionStore) load(ctx context.Context, t reactionsTable, ids []uint) (map[uint]loadedReactions, error) {
+	version_keys := make([]string, len(ids))
+	for i, id := range ids {
+		version_keys[i] = t.version(id)
+	}
+	versions, err := s.rdb.MGet(ctx, version_keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		ID       uint
+		UserID   int64
+		Reaction string
+	}
+	err = s.db.WithContext(ctx).Table(t.table).Select(t.column+" AS id", "user_id", "reaction").
+		Where(t.column+" IN ?", ids).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := make(map[uint]loadedReactions, len(ids))
+	for _, id := range ids {
+		loaded[id] = loadedReactions{counts: map[string]int64{}, users: map[int64][]string{}}
+	}
+	for _, row := range rows {
+		loaded[row.ID].counts[row.Reaction]++
+		loaded[row.ID].users[row.UserID] = append(loaded[row.ID].users[row.UserID], row.Reaction)
+	}
+
+	pipe := s.rdb.Pipeline()
+	for i, id := range ids {
+		version, _ := versions[i].(string)
+		args := []any{int64(likes_cache_ttl.Seconds()), version, 2 * len(loaded[id].counts)}
+		for reaction, count := range loaded[id].counts {
+			args = append(args, reaction, count)
+		}
+		for user_id, reactions := range loaded[id].users {
+			args = append(args, user_id, strings.Join(reactions, " "))
+		}
+		// Run can not fall back from EVALSHA to EVAL inside a pipeline
+		reactionsLoadScript.Eval(ctx, pipe, []string{t.counts(id), t.users(id), t.version(id)}, args...)
+	}
+	_, err = pipe.Exec(ctx)
+	return loaded, err
+}
@@ -0,0 +1,144 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"go_1C/storage"
+	"go_1C/storage/redis/keys"
+)
+
+type SessionStore struct {
+	rdb *redis.Client
+}
+
+func NewSessionStore(rdb *redis.Client) *SessionStore {
+	return &SessionStore{rdb: rdb}
+}
+
+// SaveRefreshToken also adds the token to the set of the user. The set lives
+// as long as the latest token, expired tokens are dropped from it here.
+func (s *SessionStore) SaveRefreshToken(ctx context.Context, token string, user_id int64, ttl time.Duration) error {
+	if err := s.dropExpired(ctx, user_id); err != nil {
+		return err
+	}
+
+	pipe := s.rdb.TxPipeline()
+	pipe.Set(ctx, keys.RefreshToken(token), user_id, ttl)
+	pipe.SAdd(ctx, keys.UserRefreshTokens(user_id), token)
+	pipe.Expire(ctx, keys.UserRefreshTokens(user_id), ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// dropExpired removes tokens which are not stored anymore from the set of
+// the user.
+func (s *SessionStore) dropExpired(ctx context.Context, user_id int64) error {
+	tokens, err := s.rdb.SMembers(ctx, keys.UserRefreshTokens(user_id)).Result()
+	if err != nil || len(tokens) == 0 {
+		return err
+	}
+
+	pipe := s.rdb.Pipeline()
+	exists := make([]*redis.IntCmd, len(tokens))
+	for i, token := range tokens {
+		exists[i] = pipe.Exists(ctx, keys.RefreshToken(token))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	expired := []any{}
+	for i, token := range tokens {
+		if exists[i].Val() == 0 {
+			expired = append(expired, token)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+	return s.rdb.SRem(ctx, keys.UserRefreshTokens(user_id), expired...).Err()
+}
+
+func (s *SessionStore) TakeRefreshToken(ctx context.Context, token string) (int64, error) {
+	value, err := s.rdb.GetDel(ctx, keys.RefreshToken(token)).Result()
+	if err == redis.Nil {
+		return 0, storage.ErrNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	user_id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return user_id, s.rdb.SRem(ctx, keys.UserRefreshTokens(user_id), token).Err()
+}
+
+func (s *SessionStore) RefreshTokenUser(ctx context.Context, token string) (int64, error) {
+	value, err := s.rdb.Get(ctx, keys.RefreshToken(token)).Result()
+	if err == redis.Nil {
+		return 0, storage.ErrNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// DeleteRefreshToken takes the token, so it is dropped from the set of its
+// user as well.
+func (s *SessionStore) DeleteRefreshToken(ctx context.Context, token string) error {
+	_, err := s.TakeRefreshToken(ctx, token)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// Token is moved together with its expiration. Token issued under the new
+// key meanwhile wins, tokens are random, so it is never the case in practice.
+var refreshTokenMoveScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+if redis.call("RENAMENX", KEYS[1], KEYS[2]) == 0 then
+	redis.call("DEL", KEYS[1])
+	return 0
+end
+return 1
+`)
+
+// MigrateRefreshTokenKeys moves refresh tokens from unversioned keys to keys
+// of package keys and returns number of moved tokens. It is safe to run it
+// again, e.g. after it was interrupted.
+func MigrateRefreshTokenKeys(ctx context.Context, rdb *redis.Client) (int, error) {
+	legacy, err := scanKeys(ctx, rdb, keys.LegacyRefreshTokenPattern)
+	if err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	for start := 0; start < len(legacy); start += warm_batch_size {
+		pipe := rdb.Pipeline()
+		var cmds []*redis.Cmd
+		for _, key := range legacy[start:min(start+warm_batch_size, len(legacy))] {
+			if token, ok := keys.ParseLegacyRefreshToken(key); ok {
+				cmds = append(cmds, refreshTokenMoveScript.Eval(ctx, pipe, []string{key, keys.RefreshToken(token)}))
+			}
+		}
+		if _, err := pipe.Exec(ctx); err != nil {
+			return moved, err
+		}
+		for _, cmd := range cmds {
+			if cmd.Val() == int64(1) {
+				moved++
+			}
+		}
+	}
+	return moved, nil
+}
@@ -0,0 +1,314 @@
+// Package storage declares stores used by the service. Postgres and redis
+// implementations live in storage/postgres and storage/redis, in-memory ones
+// in storage/memory.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go_1C/models"
+	"go_1C/pagination"
+)
+
+var (
+	ErrNotFound      = errors.New("record not found")
+	ErrAlreadyExists = errors.New("record already exists")
+)
+
+type PostOrder int
+
+const (
+	PostsOldest PostOrder = iota
+	PostsNewest
+	PostsMostCommented
+	PostsTopLiked
+	// PostsHot orders by likes / (age_hours + 2) ^ HotGravity.
+	PostsHot
+	// PostsTopScore orders by up votes minus down votes, newer posts first.
+	PostsTopScore
+)
+
+// HotGravity makes older posts sink in PostsHot order.
+const HotGravity = 1.8
+
+// PostFilter selects posts by author and creation time window.
+type PostFilter struct {
+	AuthorID      uint
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// PostQuery selects a page of posts. Cursor switches to keyset pagination,
+// Offset is ignored then. Cursor is supported by PostsOldest and PostsNewest.
+type PostQuery struct {
+	PostFilter
+	Order  PostOrder
+	Cursor *pagination.Cursor
+	Offset int64
+	Limit  int64
+}
+
+// SearchQuery is a full-text search request. PostID limits comments search
+// to one post.
+type SearchQuery struct {
+	Query    string
+	AuthorID uint
+	PostID   uint
+	Offset   int64
+	Limit    int64
+}
+
+// SearchHit is a found post or comment. Matched words in snippets are
+// wrapped into <mark></mark>.
+type SearchHit struct {
+	ID           uint
+	Rank         float32
+	TitleSnippet string
+	BodySnippet  string
+}
+
+// Posts are returned with Author loaded and CommentsCount set, Comments are
+// not loaded. Soft deleted posts are skipped unless the method says otherwise.
+type PostRepository interface {
+	// Create saves new post and fills its id, timestamps and author.
+	Create(ctx context.Context, post *models.Post) error
+	Get(ctx context.Context, id uint) (*models.Post, error)
+	// GetUnscoped returns the post even if it is soft deleted.
+	GetUnscoped(ctx context.Context, id uint) (*models.Post, error)
+	// Update saves the post together with revision of its previous version.
+	Update(ctx context.Context, post *models.Post, revision *models.PostRevision) error
+	Delete(ctx context.Context, id uint) error
+	Restore(ctx context.Context, id uint) error
+	Find(ctx context.Context, query PostQuery) ([]models.Post, error)
+	// FindByIDs returns posts in order of ids, missing posts are skipped.
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Post, error)
+	// ExistingIDs returns those of ids which belong to posts, soft deleted included.
+	ExistingIDs(ctx context.Context, ids []uint) ([]uint, error)
+	// Revisions returns previous versions of the post, the newest first.
+	Revisions(ctx context.Context, post_id uint, offset int64, limit int64) ([]models.PostRevision, error)
+	// Search returns hits ordered by rank.
+	Search(ctx context.Context, query SearchQuery) ([]SearchHit, error)
+}
+
+// Comments are returned with Author loaded. Soft deleted comments are skipped
+// unless the method says otherwise. Comments of soft deleted posts are kept
+// for restore of the post, but lists and search skip them.
+type CommentRepository interface {
+	// Create saves new comment and fills its id, timestamps and author.
+	Create(ctx context.Context, comment *models.Comment) error
+	Get(ctx context.Context, id uint) (*models.Comment, error)
+	// GetUnscoped returns the comment even if it is soft deleted.
+	GetUnscoped(ctx context.Context, id uint) (*models.Comment, error)
+	// Update saves the comment together with revision of its previous version.
+	Update(ctx context.Context, comment *models.Comment, revision *models.CommentRevision) error
+	Delete(ctx context.Context, id uint) error
+	Restore(ctx context.Context, id uint) error
+	// ListByPost returns comments of the post ordered by (created_at, id).
+	// Cursor switches to keyset pagination, offset is ignored then.
+	ListByPost(ctx context.Context, post_id uint, cursor *pagination.Cursor, offset int64, limit int64) ([]models.Comment, error)
+	// ListByAuthor returns comments of the author, the newest first.
+	ListByAuthor(ctx context.Context, author_id uint, offset int64, limit int64) ([]models.Comment, error)
+	// ListThread returns replies of the parent or top level comments of the
+	// post if parent is nil. Deleted comments with replies are returned too,
+	// so the replies are not orphaned.
+	ListThread(ctx context.Context, post_id uint, parent_id *uint, offset int64, limit int64) ([]models.Comment, error)
+	// ListReplies returns at most limit first replies of every parent,
+	// deleted replies are kept the same way as in ListThread.
+	ListReplies(ctx context.Context, parent_ids []uint, limit int64) ([]models.Comment, error)
+	// ReplyCounts returns number of not deleted direct replies by comment id.
+	ReplyCounts(ctx context.Context, ids []uint) (map[uint]int64, error)
+	// FindByIDs returns comments in order of ids, missing comments are skipped.
+	FindByIDs(ctx context.Context, ids []uint) ([]models.Comment, error)
+	// ExistingIDs returns those of ids which belong to comments, soft deleted included.
+	ExistingIDs(ctx context.Context, ids []uint) ([]uint, error)
+	// Revisions returns previous versions of the comment, the newest first.
+	Revisions(ctx context.Context, comment_id uint, offset int64, limit int64) ([]models.CommentRevision, error)
+	// Search returns hits ordered by rank.
+	Search(ctx context.Context, query SearchQuery) ([]SearchHit, error)
+}
+
+type UserRepository interface {
+	// Create saves new user with credentials. ErrAlreadyExists is returned
+	// if the login is taken.
+	Create(ctx context.Context, user *models.User, credentials *models.Credentials) error
+	Get(ctx context.Context, id uint) (*models.User, error)
+	// FindByIDs returns users in order of ids, missing users are skipped.
+	FindByIDs(ctx context.Context, ids []uint) ([]models.User, error)
+	// Update saves name, bio and avatar of the user.
+	Update(ctx context.Context, user *models.User) error
+	// GetCredentials returns credentials with User loaded.
+	GetCredentials(ctx context.Context, login string) (*models.Credentials, error)
+	// Follow returns ErrAlreadyExists if the user already follows followee.
+	Follow(ctx context.Context, follower_id uint, followee_id uint) error
+	// Unfollow returns ErrNotFound if the user does not follow followee.
+	Unfollow(ctx context.Context, follower_id uint, followee_id uint) error
+	// ListFollowers and ListFollowing return users, the latest followed first.
+	ListFollowers(ctx context.Context, user_id uint, offset int64, limit int64) ([]models.User, error)
+	ListFollowing(ctx context.Context, user_id uint, offset int64, limit int64) ([]models.User, error)
+	// FolloweeIDs returns ids of all users the user follows.
+	FolloweeIDs(ctx context.Context, user_id uint) ([]uint, error)
+}
+
+// Likes of a post or a comment.
+type Likes struct {
+	Count int64
+	// The user liked it.
+	Liked bool
+}
+
+// LikeStore keeps likes of posts and comments. Like and Unlike report false
+// if there was nothing to change.
+type LikeStore interface {
+	LikePost(ctx context.Context, post_id uint, user_id int64) (bool, error)
+	UnlikePost(ctx context.Context, post_id uint, user_id int64) (bool, error)
+	// PostLikes returns number of likes and whether the user liked the post.
+	PostLikes(ctx context.Context, post_id uint, user_id int64) (int64, bool, error)
+	// PostsLikes returns likes of every post at once.
+	PostsLikes(ctx context.Context, post_ids []uint, user_id int64) ([]Likes, error)
+	// LikedPosts reports for every post whether the user liked it.
+	LikedPosts(ctx context.Context, post_ids []uint, user_id int64) ([]bool, error)
+	// PostLikers returns ids of users who liked the post, the latest like first.
+	PostLikers(ctx context.Context, post_id uint, offset int64, limit int64) ([]uint, error)
+	// LatestPostLikers returns for every post id of the user out of user_ids
+	// who liked it the latest, or 0 if none of them liked it.
+	LatestPostLikers(ctx context.Context, post_ids []uint, user_ids []uint) ([]uint, error)
+
+	LikeComment(ctx context.Context, comment_id uint, user_id int64) (bool, error)
+	UnlikeComment(ctx context.Context, comment_id uint, user_id int64) (bool, error)
+	// CommentLikes returns number of likes and whether the user liked the comment.
+	CommentLikes(ctx context.Context, comment_id uint, user_id int64) (int64, bool, error)
+	// CommentsLikes returns likes of every comment at once.
+	CommentsLikes(ctx context.Context, comment_ids []uint, user_id int64) ([]Likes, error)
+	CommentLikers(ctx context.Context, comment_id uint, offset int64, limit int64) ([]uint, error)
+
+	// RefreshPostLikes and RefreshCommentLikes make the store reload likes
+	// from the source of truth after a change was not written to the cache.
+	RefreshPostLikes(ctx context.Context, post_id uint) error
+	RefreshCommentLikes(ctx context.Context, comment_id uint) error
+	// LikedPostIDs and LikedCommentIDs list everything having likes, they are
+	// used to find likes of removed posts and comments.
+	LikedPostIDs(ctx context.Context) ([]uint, error)
+	LikedCommentIDs(ctx context.Context) ([]uint, error)
+	// DeletePostLikes and DeleteCommentLikes drop likes of removed posts and comments.
+	DeletePostLikes(ctx context.Context, post_ids []uint) error
+	DeleteCommentLikes(ctx context.Context, comment_ids []uint) error
+}
+
+// Reactions of a post or a comment.
+type Reactions struct {
+	// Number of users by reaction, reactions nobody used are omitted.
+	Counts map[string]int64
+	// Reactions of the user in no particular order.
+	Mine []string
+}
+
+// ReactionStore keeps emoji reactions of posts and comments, the user may
+// leave several different reactions. Likes are kept by LikeStore. React and
+// Unreact report false if there was nothing to change.
+type ReactionStore interface {
+	ReactPost(ctx context.Context, post_id uint, user_id int64, reaction string) (bool, error)
+	UnreactPost(ctx context.Context, post_id uint, user_id int64, reaction string) (bool, error)
+	// PostReactions returns reactions of every post, Mine is empty if the user is 0.
+	PostReactions(ctx context.Context, post_ids []uint, user_id int64) ([]Reactions, error)
+
+	ReactComment(ctx context.Context, comment_id uint, user_id int64, reaction string) (bool, error)
+	UnreactComment(ctx context.Context, comment_id uint, user_id int64, reaction string) (bool, error)
+	CommentReactions(ctx context.Context, comment_ids []uint, user_id int64) ([]Reactions, error)
+
+	// RefreshPostReactions and RefreshCommentReactions make the store reload
+	// reactions from the source of truth after a change was not cached.
+	RefreshPostReactions(ctx context.Context, post_id uint) error
+	RefreshCommentReactions(ctx context.Context, comment_id uint) error
+}
+
+// Votes of a post or a comment.
+type Votes struct {
+	Up   int64
+	Down int64
+	// Vote of the user: 1, -1 or 0 if the user did not vote.
+	Mine int8
+}
+
+// VoteStore keeps up and down votes of posts and comments, the user has at
+// most one vote for every post and comment. Vote 0 removes the vote of the
+// user, voting the same way again changes nothing.
+type VoteStore interface {
+	// VotePost returns votes of the post after the change. ErrNotFound is
+	// returned if there is no such post.
+	VotePost(ctx context.Context, post_id uint, user_id int64, vote int8) (Votes, error)
+	// PostVotes returns votes of every post, Mine is 0 if the user is 0.
+	PostVotes(ctx context.Context, post_ids []uint, user_id int64) ([]Votes, error)
+
+	VoteComment(ctx context.Context, comment_id uint, user_id int64, vote int8) (Votes, error)
+	CommentVotes(ctx context.Context, comment_ids []uint, user_id int64) ([]Votes, error)
+}
+
+// Kinds of outbox events, EntityID of the event is id of the post or the
+// comment. Stale events are saved when the change of likes or reactions is
+// not written to the cache.
+const (
+	EventPostCreated           = "post_created"
+	EventPostDeleted           = "post_deleted"
+	EventPostRestored          = "post_restored"
+	EventPostLikesStale        = "post_likes_stale"
+	EventCommentLikesStale     = "comment_likes_stale"
+	EventPostReactionsStale    = "post_reactions_stale"
+	EventCommentReactionsStale = "comment_reactions_stale"
+)
+
+// Number of failed attempts after which outbox event is dead.
+const MaxEventAttempts = 10
+
+// DeadEventError is returned by Outbox.Process when the event failed for the
+// last time. The event is kept with DeadAt set and skipped since then.
+type DeadEventError struct {
+	Event models.OutboxEvent
+	Err   error
+}
+
+func (e *DeadEventError) Error() string {
+	return fmt.Sprintf("outbox event %d %s of %d is dead after %d attempts: %v", e.Event.ID, e.Event.Kind, e.Event.EntityID, e.Event.Attempts, e.Err)
+}
+
+func (e *DeadEventError) Unwrap() error {
+	return e.Err
+}
+
+// Outbox keeps events saved by repositories together with the changes.
+// Events are applied at least once, so appliers must be idempotent.
+type Outbox interface {
+	// Process passes up to limit pending events to apply in order of creation
+	// and deletes applied ones. Failed event is kept with the error and stops
+	// the batch, so later events of the same entity are not applied before it.
+	// After MaxEventAttempts failures the event is dead and DeadEventError is
+	// returned, so one broken event does not block the rest forever.
+	// It returns number of applied events and error of the failed one.
+	Process(ctx context.Context, limit int, apply func(event models.OutboxEvent) error) (int, error)
+}
+
+// SessionStore keeps refresh tokens.
+type SessionStore interface {
+	SaveRefreshToken(ctx context.Context, token string, user_id int64, ttl time.Duration) error
+	// TakeRefreshToken deletes the token and returns its user. ErrNotFound is
+	// returned if the token is unknown or expired.
+	TakeRefreshToken(ctx context.Context, token string) (int64, error)
+	// RefreshTokenUser returns user of the token without taking it.
+	// ErrNotFound is returned if the token is unknown or expired.
+	RefreshTokenUser(ctx context.Context, token string) (int64, error)
+	DeleteRefreshToken(ctx context.Context, token string) error
+}
+
+// FeedStore builds feeds of posts of followed users.
+type FeedStore interface {
+	// AddPost delivers new or restored post to feeds of the author's followers.
+	AddPost(ctx context.Context, post *models.Post) error
+	// RemovePost takes deleted post out of feeds of the author's followers.
+	RemovePost(ctx context.Context, post *models.Post) error
+	// Feed returns posts of followees, the newest first.
+	Feed(ctx context.Context, user_id uint, cursor *pagination.Cursor, limit int64) ([]models.Post, error)
+	// Reset must be called after followees of the user change.
+	Reset(ctx context.Context, user_id uint) error
+}
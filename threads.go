@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+const default_thread_depth int32 = 3
+
+// fillReplyCounts sets number of not deleted direct replies for every comment.
+func (s *Service) fillReplyCounts(ctx context.Context, comments []*api.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, len(comments))
+	for i, comment := range comments {
+		ids[i] = uint(comment.Id)
+	}
+
+	by_parent, err := s.Comments.ReplyCounts(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		comment.ReplyCount = by_parent[uint(comment.Id)]
+	}
+
+	return nil
+}
+
+func (s *Service) GetCommentThread(ctx context.Context, req *api.GetCommentThreadReq) (*api.GetCommentThreadRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded GetCommentThread")
+
+	if req.PostId == 0 && req.CommentId == 0 {
+		return &api.GetCommentThreadRsp{}, errs.InvalidArgument("Either post_id or comment_id must be set!")
+	}
+	if req.Depth < 0 {
+		return &api.GetCommentThreadRsp{}, errs.InvalidArgument("Depth must not be negative!")
+	}
+
+	depth := req.Depth
+	if depth == 0 {
+		depth = default_thread_depth
+	}
+
+	// first level: replies of the root comment or top level comments of the post
+	var parent_id *uint
+	if req.CommentId != 0 {
+		root, err := s.Comments.GetUnscoped(ctx, uint(req.CommentId))
+		if errors.Is(err, storage.ErrNotFound) || (err == nil && req.PostId != 0 && root.PostRefer != uint(req.PostId)) {
+			return &api.GetCommentThreadRsp{}, errs.NotFound("Comment not found!")
+		} else if err != nil {
+			return &api.GetCommentThreadRsp{}, errs.Internal(err)
+		}
+		parent_id = &root.ID
+	}
+
+	comments, err := s.Comments.ListThread(ctx, uint(req.PostId), parent_id, req.Offset, req.Limit+1)
+	if err != nil {
+		return &api.GetCommentThreadRsp{}, errs.Internal(err)
+	}
+
+	has_more := int64(len(comments)) > req.Limit
+	if has_more {
+		comments = comments[:req.Limit]
+	}
+
+	level, err := s.commentNodes(ctx, comments, user_id)
+	if err != nil {
+		return &api.GetCommentThreadRsp{}, errs.Internal(err)
+	}
+	nodes := level
+
+	// next levels: every comment gets at most limit replies
+	for d := int32(1); d < depth && len(level) > 0; d++ {
+		parents := make(map[int64]*api.CommentNode, len(level))
+		parent_ids := make([]uint, 0, len(level))
+		for _, node := range level {
+			parents[node.Comment.Id] = node
+			parent_ids = append(parent_ids, uint(node.Comment.Id))
+		}
+
+		replies, err := s.Comments.ListReplies(ctx, parent_ids, req.Limit+1)
+		if err != nil {
+			return &api.GetCommentThreadRsp{}, errs.Internal(err)
+		}
+
+		replies_nodes, err := s.commentNodes(ctx, replies, user_id)
+		if err != nil {
+			return &api.GetCommentThreadRsp{}, errs.Internal(err)
+		}
+
+		level = level[:0:0]
+		for _, node := range replies_nodes {
+			parent := parents[node.Comment.ParentCommentId]
+			if int64(len(parent.Replies)) == req.Limit {
+				parent.HasMoreReplies = true
+				continue
+			}
+			parent.Replies = append(parent.Replies, node)
+			level = append(level, node)
+		}
+	}
+
+	// replies of the last level are not loaded
+	for _, node := range level {
+		node.HasMoreReplies = node.Comment.ReplyCount > 0
+	}
+
+	return &api.GetCommentThreadRsp{Comments: nodes, HasMore: has_more}, nil
+}
+
+func (s *Service) commentNodes(ctx context.Context, comments []models.Comment, user_id int64) ([]*api.CommentNode, error) {
+	comments_rsp, err := s.commentsToAPI(ctx, comments, user_id)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*api.CommentNode, len(comments_rsp))
+	for i, comment := range comments_rsp {
+		nodes[i] = &api.CommentNode{Comment: comment}
+	}
+	return nodes, nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/url"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/models"
+	"go_1C/storage"
+)
+
+func userToAPI(user *models.User) *api.User {
+	return &api.User{
+		Id:        int64(user.ID),
+		Name:      user.Name,
+		Bio:       user.Bio,
+		AvatarUrl: user.AvatarURL,
+	}
+}
+
+func (s *Service) GetUser(ctx context.Context, req *api.GetUserReq) (*api.GetUserRsp, error) {
+	log.Println("User:", auth.UserID(ctx), "callded GetUser")
+
+	user, err := s.Users.Get(ctx, uint(req.Id))
+	if errors.Is(err, storage.ErrNotFound) {
+		return &api.GetUserRsp{}, errs.NotFound("User not found!")
+	} else if err != nil {
+		return &api.GetUserRsp{}, errs.Internal(err)
+	}
+
+	return &api.GetUserRsp{User: userToAPI(user)}, nil
+}
+
+func (s *Service) UpdateProfile(ctx context.Context, req *api.UpdateProfileReq) (*api.UpdateProfileRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.UpdateProfileRsp{}, err
+	}
+	log.Println("User:", user_id, "callded UpdateProfile")
+
+	if req.AvatarUrl != nil && *req.AvatarUrl != "" {
+		u, err := url.Parse(*req.AvatarUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &api.UpdateProfileRsp{}, errs.InvalidArgument("Avatar URL must be a valid http(s) URL!")
+		}
+	}
+
+	user, err := s.Users.Get(ctx, uint(user_id))
+	if err != nil {
+		return &api.UpdateProfileRsp{}, errs.Internal(err)
+	}
+
+	if req.Name == nil && req.Bio == nil && req.AvatarUrl == nil {
+		return &api.UpdateProfileRsp{User: userToAPI(user)}, nil
+	}
+
+	if req.Name != nil {
+		user.Name = *req.Name
+	}
+	if req.Bio != nil {
+		user.Bio = *req.Bio
+	}
+	if req.AvatarUrl != nil {
+		user.AvatarURL = *req.AvatarUrl
+	}
+
+	if err := s.Users.Update(ctx, user); err != nil {
+		return &api.UpdateProfileRsp{}, errs.Internal(err)
+	}
+
+	s.invalidatePostsCache(ctx)
+
+	return &api.UpdateProfileRsp{User: userToAPI(user)}, nil
+}
+
+func (s *Service) ListUserPosts(ctx context.Context, req *api.ListUserPostsReq) (*api.ListUserPostsRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded ListUserPosts")
+
+	posts, err := s.Posts.Find(ctx, storage.PostQuery{
+		PostFilter: storage.PostFilter{AuthorID: uint(req.AuthorId)},
+		Order:      storage.PostsNewest,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+	})
+	if err != nil {
+		return &api.ListUserPostsRsp{}, errs.Internal(err)
+	}
+
+	posts_rsp, err := s.postsToAPI(ctx, posts, user_id)
+	if err != nil {
+		return &api.ListUserPostsRsp{}, errs.Internal(err)
+	}
+
+	return &api.ListUserPostsRsp{Posts: posts_rsp}, nil
+}
+
+func (s *Service) ListUserComments(ctx context.Context, req *api.ListUserCommentsReq) (*api.ListUserCommentsRsp, error) {
+	user_id := auth.UserID(ctx)
+	log.Println("User:", user_id, "callded ListUserComments")
+
+	comments, err := s.Comments.ListByAuthor(ctx, uint(req.AuthorId), req.Offset, req.Limit)
+	if err != nil {
+		return &api.ListUserCommentsRsp{}, errs.Internal(err)
+	}
+
+	comments_rsp, err := s.commentsToAPI(ctx, comments, user_id)
+	if err != nil {
+		return &api.ListUserCommentsRsp{}, errs.Internal(err)
+	}
+
+	return &api.ListUserCommentsRsp{Comments: comments_rsp}, nil
+}
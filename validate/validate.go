@@ -0,0 +1,117 @@
+// Package validate checks requests against rules declared on fields of
+// server.proto with the (validate) option.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	api "go_1C/api"
+)
+
+// UnaryServerInterceptor rejects invalid requests with InvalidArgument
+// carrying google.rpc.BadRequest, the gateway turns it into HTTP 400.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := Request(msg); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Request returns InvalidArgument error listing all violated rules of msg,
+// or nil if msg is valid.
+func Request(msg proto.Message) error {
+	violations := Violations(msg)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(violations))
+	for i, violation := range violations {
+		descriptions[i] = violation.Field + " " + violation.Description
+	}
+
+	st := status.New(codes.InvalidArgument, "Invalid request: "+strings.Join(descriptions, ", ")+"!")
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// Violations checks fields of msg and of messages nested in it.
+// Fields are named by their paths, e.g. "post.title".
+func Violations(msg proto.Message) []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	check(msg.ProtoReflect(), "", &violations)
+	return violations
+}
+
+func check(msg protoreflect.Message, prefix string, violations *[]*errdetails.BadRequest_FieldViolation) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		path := prefix + string(field.Name())
+		rules, _ := proto.GetExtension(field.Options(), api.E_Validate).(*api.FieldRules)
+
+		violate := func(format string, args ...any) {
+			*violations = append(*violations, &errdetails.BadRequest_FieldViolation{
+				Field:       path,
+				Description: fmt.Sprintf(format, args...),
+			})
+		}
+
+		if field.Kind() == protoreflect.MessageKind && field.Cardinality() != protoreflect.Repeated {
+			if msg.Has(field) {
+				check(msg.Get(field).Message(), path+".", violations)
+			} else if rules.GetRequired() {
+				violate("is required")
+			}
+			continue
+		}
+
+		// optional field is checked only if it is set
+		if rules == nil || (field.HasPresence() && !msg.Has(field)) {
+			continue
+		}
+
+		value := msg.Get(field)
+		switch field.Kind() {
+		case protoreflect.StringKind:
+			length := uint32(utf8.RuneCountInString(value.String()))
+			if rules.MinLen != nil && length < rules.GetMinLen() {
+				if rules.GetMinLen() == 1 {
+					violate("must not be empty")
+				} else {
+					violate("must be at least %d characters long", rules.GetMinLen())
+				}
+			}
+			if rules.MaxLen != nil && length > rules.GetMaxLen() {
+				violate("must be at most %d characters long", rules.GetMaxLen())
+			}
+
+		case protoreflect.Int32Kind, protoreflect.Int64Kind,
+			protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+			protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+			number := value.Int()
+			if rules.Gte != nil && number < rules.GetGte() {
+				violate("must be greater than or equal to %d", rules.GetGte())
+			}
+			if rules.Lte != nil && number > rules.GetLte() {
+				violate("must be less than or equal to %d", rules.GetLte())
+			}
+		}
+	}
+}
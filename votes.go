@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	api "go_1C/api"
+	"go_1C/auth"
+	"go_1C/errs"
+	"go_1C/storage"
+)
+
+func voteFromAPI(vote api.Vote) (int8, bool) {
+	switch vote {
+	case api.Vote_VOTE_NONE:
+		return 0, true
+	case api.Vote_VOTE_UP:
+		return 1, true
+	case api.Vote_VOTE_DOWN:
+		return -1, true
+	default:
+		return 0, false
+	}
+}
+
+func votesToAPI(votes storage.Votes) *api.Votes {
+	votes_rsp := &api.Votes{Score: votes.Up - votes.Down, Up: votes.Up, Down: votes.Down}
+	switch votes.Mine {
+	case 1:
+		votes_rsp.Mine = api.Vote_VOTE_UP
+	case -1:
+		votes_rsp.Mine = api.Vote_VOTE_DOWN
+	}
+	return votes_rsp
+}
+
+// fillPostVotes sets votes of posts in one round trip.
+func (s *Service) fillPostVotes(ctx context.Context, posts []*api.Post, user_id int64) error {
+	post_ids := make([]uint, len(posts))
+	for i, post := range posts {
+		post_ids[i] = uint(post.Id)
+	}
+
+	votes, err := s.Votes.PostVotes(ctx, post_ids, user_id)
+	if err != nil {
+		return err
+	}
+
+	for i, post := range posts {
+		post.Votes = votesToAPI(votes[i])
+	}
+	return nil
+}
+
+// fillCommentVotes sets votes of comments in one round trip.
+func (s *Service) fillCommentVotes(ctx context.Context, comments []*api.Comment, user_id int64) error {
+	comment_ids := make([]uint, len(comments))
+	for i, comment := range comments {
+		comment_ids[i] = uint(comment.Id)
+	}
+
+	votes, err := s.Votes.CommentVotes(ctx, comment_ids, user_id)
+	if err != nil {
+		return err
+	}
+
+	for i, comment := range comments {
+		comment.Votes = votesToAPI(votes[i])
+	}
+	return nil
+}
+
+func (s *Service) Vote(ctx context.Context, req *api.VoteReq) (*api.VoteRsp, error) {
+	user_id, err := auth.RequireUser(ctx)
+	if err != nil {
+		return &api.VoteRsp{}, err
+	}
+	log.Println("User:", user_id, "callded Vote")
+
+	if (req.PostId == 0) == (req.CommentId == 0) {
+		return &api.VoteRsp{}, errs.InvalidArgument("Exactly one of post_id and comment_id must be set!")
+	}
+	vote, ok := voteFromAPI(req.Vote)
+	if !ok {
+		return &api.VoteRsp{}, errs.InvalidArgument("Unknown vote!")
+	}
+
+	var votes storage.Votes
+	if req.PostId != 0 {
+		if _, err := s.Posts.Get(ctx, uint(req.PostId)); errors.Is(err, storage.ErrNotFound) {
+			return &api.VoteRsp{}, errs.NotFound("Post not found!")
+		} else if err != nil {
+			return &api.VoteRsp{}, errs.Internal(err)
+		}
+
+		votes, err = s.Votes.VotePost(ctx, uint(req.PostId), user_id, vote)
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.VoteRsp{}, errs.NotFound("Post not found!")
+		} else if err != nil {
+			return &api.VoteRsp{}, errs.Internal(err)
+		}
+
+		s.invalidatePostsCache(ctx)
+	} else {
+		if _, err := s.Comments.Get(ctx, uint(req.CommentId)); errors.Is(err, storage.ErrNotFound) {
+			return &api.VoteRsp{}, errs.NotFound("Comment not found!")
+		} else if err != nil {
+			return &api.VoteRsp{}, errs.Internal(err)
+		}
+
+		votes, err = s.Votes.VoteComment(ctx, uint(req.CommentId), user_id, vote)
+		if errors.Is(err, storage.ErrNotFound) {
+			return &api.VoteRsp{}, errs.NotFound("Comment not found!")
+		} else if err != nil {
+			return &api.VoteRsp{}, errs.Internal(err)
+		}
+	}
+
+	return &api.VoteRsp{Votes: votesToAPI(votes)}, nil
+}